@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/demidshumakher/loglinter/pkg/catalog"
+	"github.com/demidshumakher/loglinter/pkg/diff"
+	"github.com/demidshumakher/loglinter/pkg/report"
+)
+
+// changedLines returns the lines changed according to the -diff or
+// -new-from-rev flag, with absolute file paths, or nil if neither is set.
+func changedLines() (diff.Changes, error) {
+	var patch io.Reader
+	switch {
+	case *diffPath == "-":
+		patch = os.Stdin
+	case *diffPath != "":
+		f, err := os.Open(*diffPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read diff: %w", err)
+		}
+		defer f.Close()
+		patch = f
+	case *newFromRev != "":
+		out, err := git("diff", "--no-color", "--no-ext-diff", "--no-renames", "-U0", *newFromRev, "--")
+		if err != nil {
+			return nil, err
+		}
+		patch = bytes.NewReader(out)
+	default:
+		return nil, nil
+	}
+
+	changes, err := diff.Parse(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse diff: %w", err)
+	}
+
+	// git diff leaves out untracked files, which are new in every line.
+	if *newFromRev != "" {
+		out, err := git("ls-files", "--others", "--exclude-standard", "--full-name", "-z", "--", ":/")
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range strings.Split(string(out), "\x00") {
+			if file != "" {
+				changes.AddFile(file)
+			}
+		}
+	}
+
+	// Paths in git diffs are relative to the repository root.
+	root, err := repositoryRoot()
+	if err != nil {
+		return nil, err
+	}
+	return changes.Rebase(root), nil
+}
+
+// repositoryRoot returns the root of the git repository containing the
+// working directory, or the working directory for a diff file outside git.
+func repositoryRoot() (string, error) {
+	out, err := git("rev-parse", "--show-toplevel")
+	if err != nil {
+		if *newFromRev != "" {
+			return "", err
+		}
+		return os.Getwd()
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func git(args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// changedCalls keeps the log calls that overlap changed lines.
+func changedCalls(calls []catalog.Entry, changes diff.Changes) []catalog.Entry {
+	var kept []catalog.Entry
+	for _, call := range calls {
+		if changes.Overlaps(call.File, call.Line, call.EndLine) {
+			kept = append(kept, call)
+		}
+	}
+	return kept
+}
+
+// filterChanged keeps the findings whose log call overlaps changed lines.
+// Findings outside log calls must overlap changed lines themselves.
+func filterChanged(findings []report.Finding, calls []catalog.Entry, changes diff.Changes) []report.Finding {
+	var kept []report.Finding
+	for _, f := range findings {
+		file, err := filepath.Abs(f.Pos.File)
+		if err != nil {
+			continue
+		}
+
+		start, end := f.Pos.Line, f.End.Line
+		for _, call := range calls {
+			if call.File == file && call.Line <= f.Pos.Line && f.Pos.Line <= call.EndLine {
+				start, end = min(start, call.Line), max(end, call.EndLine)
+			}
+		}
+		if changes.Overlaps(file, start, end) {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,316 @@
+// Command loglinter runs the loglinter analyzer without golangci-lint and
+// writes its findings as plain text, JSON or SARIF.
+//
+// Usage:
+//
+//	loglinter [-config loglinter.yml] [-format text|json|sarif] [packages]
+//	loglinter -write-baseline loglinter-baseline.json [packages]
+//	loglinter -baseline loglinter-baseline.json [packages]
+//	loglinter -new-from-rev origin/main [packages]
+//	git diff origin/main | loglinter -diff - [packages]
+//	loglinter -stats [-format text|json] [packages]
+//	loglinter [-format text|json] rules
+//
+// The config file holds the same settings as the golangci-lint plugin:
+//
+//	rules:
+//	    lowercase:
+//	        enabled: true
+//	        severity: error
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+	"gopkg.in/yaml.v3"
+
+	"github.com/demidshumakher/loglinter/pkg/analyzer"
+	"github.com/demidshumakher/loglinter/pkg/baseline"
+	"github.com/demidshumakher/loglinter/pkg/catalog"
+	"github.com/demidshumakher/loglinter/pkg/report"
+	"github.com/demidshumakher/loglinter/pkg/stats"
+)
+
+const (
+	exitOK       = 0
+	exitFindings = 1
+	exitError    = 2
+)
+
+var (
+	configPath = flag.String("config", "", "path to a YAML file with the linter settings")
+	format     = flag.String("format", "text", "output format: text, json or sarif")
+	tests      = flag.Bool("test", true, "also analyze test files")
+	showStats  = flag.Bool("stats", false, "print statistics of log calls and violations instead of the findings")
+
+	baselinePath      = flag.String("baseline", "", "report only findings missing from this baseline file")
+	writeBaselinePath = flag.String("write-baseline", "", "write the current findings to this baseline file and exit")
+
+	diffPath   = flag.String("diff", "", "report only findings on lines changed by this unified diff file, - for stdin")
+	newFromRev = flag.String("new-from-rev", "", "report only findings on lines changed since this git revision")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("loglinter: ")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: loglinter [flags] [packages]\n       loglinter [-format text|json] rules\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var code int
+	var err error
+	if flag.Arg(0) == "rules" {
+		code, err = listRules(os.Stdout)
+	} else {
+		code, err = run(os.Stdout, flag.Args())
+	}
+	if err != nil {
+		log.Print(err)
+	}
+	os.Exit(code)
+}
+
+func run(w io.Writer, patterns []string) (int, error) {
+	write, err := writer(*format)
+	if err != nil {
+		return exitError, err
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		return exitError, err
+	}
+	// Building the rules reports an invalid configuration before any
+	// package is loaded.
+	infos, err := ruleInfos(cfg)
+	if err != nil {
+		return exitError, err
+	}
+
+	if *showStats && *format == "sarif" {
+		return exitError, errors.New("-stats supports only the text and json formats")
+	}
+	if *diffPath != "" && *newFromRev != "" {
+		return exitError, errors.New("-diff and -new-from-rev cannot be used together")
+	}
+	changes, err := changedLines()
+	if err != nil {
+		return exitError, err
+	}
+
+	findings, calls, err := analyze(cfg, patterns)
+	if err != nil {
+		return exitError, err
+	}
+
+	if *writeBaselinePath != "" {
+		if err := baseline.New(findings).Write(*writeBaselinePath); err != nil {
+			return exitError, err
+		}
+		log.Printf("wrote %d findings to %s", len(findings), *writeBaselinePath)
+		return exitOK, nil
+	}
+
+	if *baselinePath != "" {
+		b, err := baseline.Load(*baselinePath)
+		if err != nil {
+			return exitError, err
+		}
+		var fixed []baseline.Entry
+		findings, fixed = b.Filter(findings)
+		reportFixed(fixed)
+	}
+
+	if changes != nil {
+		findings = filterChanged(findings, calls, changes)
+		calls = changedCalls(calls, changes)
+	}
+
+	if *showStats {
+		return exitOK, writeStats(w, stats.Compute(calls, findings))
+	}
+
+	if err := write(w, findings, infos); err != nil {
+		return exitError, err
+	}
+	if len(findings) > 0 {
+		return exitFindings, nil
+	}
+	return exitOK, nil
+}
+
+func writeStats(w io.Writer, s *stats.Stats) error {
+	if *format == "json" {
+		return s.WriteJSON(w)
+	}
+	return s.WriteText(w)
+}
+
+type writeFunc func(w io.Writer, findings []report.Finding, rules []report.RuleInfo) error
+
+func writer(format string) (writeFunc, error) {
+	switch format {
+	case "text":
+		return func(w io.Writer, findings []report.Finding, _ []report.RuleInfo) error {
+			return report.WriteText(w, findings)
+		}, nil
+	case "json":
+		return func(w io.Writer, findings []report.Finding, _ []report.RuleInfo) error {
+			return report.WriteJSON(w, findings)
+		}, nil
+	case "sarif":
+		return report.WriteSARIF, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q, want text, json or sarif", format)
+}
+
+// loadConfig reads the linter settings. Without a path the defaults are used.
+func loadConfig(path string) (map[string]any, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg map[string]any
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// analyze runs the analyzer on the packages and returns its findings and the
+// log calls of the packages.
+func analyze(cfg map[string]any, patterns []string) ([]report.Finding, []catalog.Entry, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadAllSyntax, Tests: *tests}, patterns...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, nil, errors.New("failed to load packages")
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{analyzer.Analyzer(cfg)}, pkgs, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var findings []report.Finding
+	var calls []catalog.Entry
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", act.Package.PkgPath, act.Err)
+		}
+		calls = append(calls, act.Result.([]catalog.Entry)...)
+		for _, diag := range act.Diagnostics {
+			finding := report.NewFinding(act.Package.Fset, act.Package.PkgPath, diag, severity(cfg, diag.Category))
+			finding.Function = enclosingFunc(act.Package, diag.Pos)
+			relativize(&finding)
+			findings = append(findings, finding)
+		}
+	}
+	return report.Sort(findings), calls, nil
+}
+
+// reportFixed lists baseline entries without findings, so that the baseline
+// can be rewritten to shrink.
+func reportFixed(fixed []baseline.Entry) {
+	for _, e := range fixed {
+		where := e.Package
+		if e.Function != "" {
+			where += "." + e.Function
+		}
+		log.Printf("fixed %d baseline finding(s) of %s in %s: %s", e.Count, e.Rule, where, e.Message)
+	}
+	if len(fixed) > 0 {
+		log.Printf("rewrite the baseline with -write-baseline to drop fixed findings")
+	}
+}
+
+// enclosingFunc returns the name of the function declaration containing pos.
+func enclosingFunc(pkg *packages.Package, pos token.Pos) string {
+	for _, file := range pkg.Syntax {
+		if pos < file.Pos() || pos >= file.End() {
+			continue
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Pos() <= pos && pos < fn.End() {
+				return analyzer.FuncName(fn)
+			}
+		}
+	}
+	return ""
+}
+
+// ruleInfos describes the enabled rules.
+func ruleInfos(cfg map[string]any) ([]report.RuleInfo, error) {
+	enabled, err := analyzer.EnabledRules(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []report.RuleInfo
+	for _, rule := range enabled {
+		infos = append(infos, report.RuleInfo{
+			ID:          rule.Name(),
+			Description: rule.Description(),
+			Severity:    severity(cfg, rule.Name()),
+		})
+	}
+	return infos, nil
+}
+
+// severity returns the severity set for a rule by its "severity" setting.
+func severity(cfg map[string]any, rule string) string {
+	rules, _ := cfg["rules"].(map[string]any)
+	settings, _ := rules[rule].(map[string]any)
+	if severity, ok := settings["severity"].(string); ok {
+		return severity
+	}
+	return report.SeverityWarning
+}
+
+// relativize makes the file paths of a finding relative to the working
+// directory when they are inside it.
+func relativize(f *report.Finding) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return
+	}
+	rel := func(p *report.Position) {
+		if r, err := filepath.Rel(wd, p.File); err == nil && !strings.HasPrefix(r, "..") {
+			p.File = r
+		}
+	}
+
+	rel(&f.Pos)
+	rel(&f.End)
+	for i := range f.Fixes {
+		for j := range f.Fixes[i].Edits {
+			rel(&f.Fixes[i].Edits[j].Start)
+			rel(&f.Fixes[i].Edits[j].End)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/demidshumakher/loglinter/pkg/report"
+	"github.com/demidshumakher/loglinter/pkg/rules"
+	"github.com/demidshumakher/loglinter/pkg/stats"
+)
+
+// The package has a lowercase finding on line 6 and a no_special_chars
+// finding on line 8.
+const testPackage = "../../testdata/src/cli"
+
+// setup runs the test in the test package directory and resets the flags
+// set by the test when it ends.
+func setup(t *testing.T, flags map[string]string) {
+	t.Helper()
+
+	dir, err := filepath.Abs(testPackage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	for name, value := range flags {
+		setFlag(t, name, value)
+	}
+}
+
+// setFlag sets a command line flag until the test ends.
+func setFlag(t *testing.T, name, value string) {
+	t.Helper()
+
+	f := flag.Lookup(name)
+	if err := f.Value.Set(value); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Value.Set(f.DefValue) })
+}
+
+func runOK(t *testing.T, wantCode int) string {
+	t.Helper()
+
+	var out bytes.Buffer
+	code, err := run(&out, []string{"."})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if code != wantCode {
+		t.Fatalf("run exited with %d, want %d\n%s", code, wantCode, out.String())
+	}
+	return out.String()
+}
+
+func TestRunText(t *testing.T) {
+	setup(t, nil)
+
+	want := `cli.go:6:12: log message should start with a lowercase letter (lowercase)
+cli.go:8:30: log message should not contain special characters or emojis (no_special_chars)
+`
+	if got := runOK(t, exitFindings); got != want {
+		t.Errorf("text output:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestRunJSONSeverity(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "loglinter.yml")
+	if err := os.WriteFile(config, []byte("rules:\n    lowercase:\n        severity: error\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	setup(t, map[string]string{"format": "json", "config": config})
+
+	var findings []report.Finding
+	if err := json.Unmarshal([]byte(runOK(t, exitFindings)), &findings); err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 2 {
+		t.Fatalf("got %d findings, want 2", len(findings))
+	}
+
+	lowercase, special := findings[0], findings[1]
+	if lowercase.RuleID != "lowercase" || lowercase.Severity != report.SeverityError {
+		t.Errorf("lowercase finding: %+v", lowercase)
+	}
+	if special.RuleID != "no_special_chars" || special.Severity != report.SeverityWarning {
+		t.Errorf("no_special_chars finding: %+v", special)
+	}
+	if lowercase.Function != "serve" || lowercase.Pos.File != "cli.go" || lowercase.Fixes[0].Edits[0].Start.File != "cli.go" {
+		t.Errorf("positions are not relative to the working directory: %+v", lowercase)
+	}
+}
+
+func TestRunSARIF(t *testing.T) {
+	setup(t, map[string]string{"format": "sarif"})
+
+	var log struct {
+		Runs []struct {
+			Results []struct {
+				RuleID string `json:"ruleId"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal([]byte(runOK(t, exitFindings)), &log); err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 2 {
+		t.Errorf("SARIF log: %+v", log)
+	}
+}
+
+func TestRunUnknownFormat(t *testing.T) {
+	setup(t, map[string]string{"format": "xml"})
+
+	code, err := run(new(bytes.Buffer), []string{"."})
+	if code != exitError || err == nil || !strings.Contains(err.Error(), `unknown output format "xml"`) {
+		t.Errorf("run returned %d, %v", code, err)
+	}
+}
+
+func TestRunNoFindings(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "loglinter.yml")
+	settings := "rules:\n    lowercase:\n        enabled: false\n    no_special_chars:\n        enabled: false\n"
+	if err := os.WriteFile(config, []byte(settings), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	setup(t, map[string]string{"config": config})
+
+	if got := runOK(t, exitOK); got != "" {
+		t.Errorf("unexpected output: %s", got)
+	}
+}
+
+func TestRunConfigError(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "loglinter.yml")
+	if err := os.WriteFile(config, []byte("rules:\n    attr_keys:\n        enabled: true\n        style: pascal\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	setup(t, map[string]string{"config": config})
+
+	code, err := run(new(bytes.Buffer), []string{"."})
+	if code != exitError || err == nil || !strings.Contains(err.Error(), `attr_keys: unknown style "pascal"`) {
+		t.Errorf("run returned %d, %v", code, err)
+	}
+}
+
+func TestRunBaseline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	setup(t, map[string]string{"write-baseline": path})
+
+	if got := runOK(t, exitOK); got != "" {
+		t.Errorf("-write-baseline wrote findings: %s", got)
+	}
+	setFlag(t, "write-baseline", "")
+	setFlag(t, "baseline", path)
+	if got := runOK(t, exitOK); got != "" {
+		t.Errorf("findings in the baseline are reported: %s", got)
+	}
+}
+
+// writeDiff writes a diff changing one line of the test package and returns
+// its path. It must be called in the test package directory.
+func writeDiff(t *testing.T, line int) string {
+	t.Helper()
+
+	root, err := repositoryRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := filepath.Abs("cli.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := filepath.Rel(root, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patch := fmt.Sprintf("--- a/%s\n+++ b/%s\n@@ -%d +%d @@\n-\tslog.Info(\"old\")\n+\tslog.Info(\"new\")\n", name, name, line, line)
+	path := filepath.Join(t.TempDir(), "change.diff")
+	if err := os.WriteFile(path, []byte(patch), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunDiff(t *testing.T) {
+	setup(t, nil)
+	setFlag(t, "diff", writeDiff(t, 8))
+
+	want := "cli.go:8:30: log message should not contain special characters or emojis (no_special_chars)\n"
+	if got := runOK(t, exitFindings); got != want {
+		t.Errorf("-diff output:\n got %s\nwant %s", got, want)
+	}
+
+	setFlag(t, "new-from-rev", "HEAD")
+	if code, err := run(new(bytes.Buffer), []string{"."}); code != exitError || err == nil {
+		t.Errorf("-diff with -new-from-rev returned %d, %v", code, err)
+	}
+}
+
+func TestRunNewFromRevUntracked(t *testing.T) {
+	setup(t, map[string]string{"new-from-rev": "HEAD"})
+
+	src := "package cli\n\nimport \"log/slog\"\n\nfunc stop() {\n\tslog.Info(\"Stopping server\")\n}\n"
+	if err := os.WriteFile("untracked.go", []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Remove("untracked.go") })
+
+	want := "untracked.go:6:12: log message should start with a lowercase letter (lowercase)\n"
+	if got := runOK(t, exitFindings); got != want {
+		t.Errorf("-new-from-rev output:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestRunStatsDiff(t *testing.T) {
+	setup(t, map[string]string{"stats": "true", "format": "json"})
+	setFlag(t, "diff", writeDiff(t, 8))
+
+	var s stats.Stats
+	if err := json.Unmarshal([]byte(runOK(t, exitOK)), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Calls != 1 || s.Levels["warn"] != 1 || s.Violations["lowercase"] != 0 || s.Violations["no_special_chars"] != 1 {
+		t.Errorf("stats of changed lines: %+v", s)
+	}
+}
+
+func TestRunStatsSARIF(t *testing.T) {
+	setup(t, map[string]string{"stats": "true", "format": "sarif"})
+
+	if code, err := run(new(bytes.Buffer), []string{"."}); code != exitError || err == nil {
+		t.Errorf("-stats -format sarif returned %d, %v", code, err)
+	}
+}
+
+func TestRunStats(t *testing.T) {
+	setup(t, map[string]string{"stats": "true", "format": "json"})
+
+	var s stats.Stats
+	if err := json.Unmarshal([]byte(runOK(t, exitOK)), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Calls != 3 || s.Levels["info"] != 2 || s.Violations["lowercase"] != 1 || s.Violations["no_special_chars"] != 1 {
+		t.Errorf("stats: %+v", s)
+	}
+}
+
+func TestListRules(t *testing.T) {
+	var out bytes.Buffer
+	code, err := listRules(&out)
+	if err != nil || code != exitOK {
+		t.Fatalf("listRules returned %d, %v", code, err)
+	}
+	if !strings.HasPrefix(out.String(), "LL001  lowercase         enabled   Checks that log messages start with a lowercase letter\n") {
+		t.Errorf("rules list:\n%s", out.String())
+	}
+
+	setup(t, map[string]string{"format": "json"})
+	out.Reset()
+	if _, err := listRules(&out); err != nil {
+		t.Fatal(err)
+	}
+	var descriptions []rules.RuleDescription
+	if err := json.Unmarshal(out.Bytes(), &descriptions); err != nil {
+		t.Fatal(err)
+	}
+	if len(descriptions) == 0 || descriptions[0].Name != "lowercase" || !descriptions[0].Enabled {
+		t.Errorf("rules in JSON: %+v", descriptions)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/demidshumakher/loglinter/pkg/rules"
+)
+
+// listRules writes the registered rules with their options and examples.
+func listRules(w io.Writer) (int, error) {
+	descriptions := rules.DescribeRules()
+
+	if *format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(descriptions); err != nil {
+			return exitError, err
+		}
+		return exitOK, nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, desc := range descriptions {
+		state := "enabled"
+		if !desc.Enabled {
+			state = "disabled"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", desc.Code, desc.Name, state, desc.Description)
+		for _, opt := range desc.Options {
+			fmt.Fprintf(tw, "\t\toption\t%s %s: %s", opt.Name, opt.Type, opt.Description)
+			if opt.Default != "" {
+				fmt.Fprintf(tw, " (default %s)", opt.Default)
+			}
+			fmt.Fprintln(tw)
+		}
+		for _, example := range desc.Examples {
+			fmt.Fprintf(tw, "\t\texample\t%s\n", example)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return exitError, err
+	}
+	return exitOK, nil
+}
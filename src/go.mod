@@ -7,6 +7,7 @@ toolchain go1.24.2
 require (
 	github.com/golangci/plugin-module-register v0.1.1
 	golang.org/x/tools v0.28.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
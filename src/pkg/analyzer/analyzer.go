@@ -3,48 +3,78 @@ package analyzer
 import (
 	"go/ast"
 	"go/types"
+	"reflect"
 	"slices"
 	"strings"
+	"sync"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/ctrlflow"
 	"golang.org/x/tools/go/analysis/passes/inspect"
-	"golang.org/x/tools/go/ast/inspector"
 
+	"github.com/demidshumakher/loglinter/pkg/catalog"
+	"github.com/demidshumakher/loglinter/pkg/logcall"
 	"github.com/demidshumakher/loglinter/pkg/rules"
 )
 
-const (
-	slogPackage = "log/slog"
-	zapPackage  = "go.uber.org/zap"
-)
-
-var logMethods = map[string][]string{
-	slogPackage: {"Debug", "Info", "Warn", "Error", "Log", "LogAttrs"},
-	zapPackage:  {"Debug", "Info", "Warn", "Error", "DPanic", "Panic", "Fatal"},
+// Analyzer returns the loglinter analyzer. Its result is the catalog of the
+// log calls of the package, a []catalog.Entry. The rules are built and
+// configured on the first run, and an invalid configuration fails every run.
+func Analyzer(cfg any) *analysis.Analyzer {
+	config := parseConfig(cfg)
+	return newAnalyzer(config, sync.OnceValues(func() ([]rules.Rule, error) {
+		return getRules(config)
+	}))
 }
 
-func Analyzer(cfg any) *analysis.Analyzer {
+// newAnalyzer returns the loglinter analyzer running the rules returned by
+// configured.
+func newAnalyzer(config rulesConfig, configured func() ([]rules.Rule, error)) *analysis.Analyzer {
 	return &analysis.Analyzer{
-		Name:     "loglinter",
-		Doc:      "Checks log messages for compliance with logging best practices",
-		Run:      makeRunFunc(cfg),
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Name:       "loglinter",
+		Doc:        "Checks log messages for compliance with logging best practices",
+		Run:        makeRunFunc(config, configured),
+		Requires:   []*analysis.Analyzer{logcall.Analyzer, inspect.Analyzer, ctrlflow.Analyzer},
+		FactTypes:  factTypes(),
+		ResultType: reflect.TypeOf([]catalog.Entry(nil)),
 	}
 }
 
-func makeRunFunc(cfg any) func(*analysis.Pass) (interface{}, error) {
-	return func(pass *analysis.Pass) (interface{}, error) {
-		rules.Init()
+func factTypes() []analysis.Fact {
+	allRules, _ := rules.GetAllRules()
+	var facts []analysis.Fact
+	for _, rule := range allRules {
+		if pr, ok := rule.(rules.PackageRule); ok {
+			facts = append(facts, pr.FactTypes()...)
+		}
+	}
+	return facts
+}
 
-		config := parseConfig(cfg)
-		allRules := getRules(config)
-		executor := newRuleExecutor(allRules, pass)
-		analyzeCode(pass, executor)
+func makeRunFunc(config rulesConfig, configured func() ([]rules.Rule, error)) func(*analysis.Pass) (interface{}, error) {
+	return func(pass *analysis.Pass) (interface{}, error) {
+		allRules, err := configured()
+		if err != nil {
+			return nil, err
+		}
+		executor, err := newRuleExecutor(allRules, pass, config)
+		if err != nil {
+			return nil, err
+		}
+		executor.catalog = true
+		executor.executeAll()
+		executor.finish()
 
-		return nil, nil
+		return executor.entries, nil
 	}
 }
 
+// EnabledRules returns the rules enabled by cfg, configured the way the
+// analyzer configures them.
+func EnabledRules(cfg any) ([]rules.Rule, error) {
+	return getRules(parseConfig(cfg))
+}
+
 func parseConfig(cfg any) rulesConfig {
 	result := rulesConfig{
 		Rules: make(map[string]ruleConfig),
@@ -59,6 +89,13 @@ func parseConfig(cfg any) rulesConfig {
 		return result
 	}
 
+	if perRule, ok := cfgMap["analyzer_per_rule"].(bool); ok {
+		result.PerRule = perRule
+	}
+	if stdLog, ok := cfgMap["check_std_log"].(bool); ok {
+		result.StdLog = stdLog
+	}
+
 	if rulesCfg, ok := cfgMap["rules"].(map[string]any); ok {
 		for ruleName, ruleCfg := range rulesCfg {
 			if ruleData, ok := ruleCfg.(map[string]any); ok {
@@ -81,6 +118,10 @@ func parseConfig(cfg any) rulesConfig {
 
 type rulesConfig struct {
 	Rules map[string]ruleConfig
+	// PerRule makes Analyzers return an analyzer for every rule.
+	PerRule bool
+	// StdLog makes every rule check calls of the standard log package.
+	StdLog bool
 }
 
 type ruleConfig struct {
@@ -88,141 +129,223 @@ type ruleConfig struct {
 	Data    map[string]any
 }
 
-func getRules(cfg rulesConfig) []rules.Rule {
-	allRules, _ := rules.GetAllRules()
+func getRules(cfg rulesConfig) ([]rules.Rule, error) {
+	allRules, err := rules.GetAllRules()
+	if err != nil {
+		return nil, err
+	}
 	enabledRules := make([]rules.Rule, 0, len(allRules))
 
 	for _, rule := range allRules {
-		enabled := true
-		if rc, exists := cfg.Rules[rule.Name()]; exists {
-			if rc.Enabled != nil {
-				enabled = *rc.Enabled
-			}
-			if len(rc.Data) > 0 {
-				rule.Configure(rc.Data)
-			}
+		enabled, err := configureRule(rule, cfg)
+		if err != nil {
+			return nil, err
 		}
 		if enabled {
 			enabledRules = append(enabledRules, rule)
 		}
 	}
 
-	return enabledRules
+	return enabledRules, nil
 }
 
-func analyzeCode(pass *analysis.Pass, executor *ruleExecutor) {
-	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
-
-	nodeFilter := []ast.Node{(*ast.CallExpr)(nil)}
-	insp.Preorder(nodeFilter, func(n ast.Node) {
-		switch node := n.(type) {
-		case *ast.CallExpr:
-			handleCallExpr(node, executor, pass)
+// configureRule applies the settings of the rule and reports whether it is
+// enabled.
+func configureRule(rule rules.Rule, cfg rulesConfig) (bool, error) {
+	enabled := rule.Enabled()
+	if rc, exists := cfg.Rules[rule.Name()]; exists {
+		if rc.Enabled != nil {
+			enabled = *rc.Enabled
+			rule.SetEnabled(enabled)
+		}
+		if len(rc.Data) > 0 {
+			if err := rule.Configure(rc.Data); err != nil {
+				return false, err
+			}
 		}
-	})
+	}
+	return enabled, nil
 }
 
-func handleCallExpr(node *ast.CallExpr, executor *ruleExecutor, pass *analysis.Pass) {
-	sel, ok := node.Fun.(*ast.SelectorExpr)
-	if !ok {
-		return
+// packageRules returns the rules checking one package. Rules keeping state
+// for a package are copied or, without ForPackage, built again.
+func packageRules(configured []rules.Rule, cfg rulesConfig) ([]rules.Rule, error) {
+	result := make([]rules.Rule, 0, len(configured))
+	for _, rule := range configured {
+		switch r := rule.(type) {
+		case rules.StatefulRule:
+			rule = r.ForPackage()
+		case rules.FinishRule:
+			fresh, err := rules.GetRule(rule.Name())
+			if err != nil {
+				return nil, err
+			}
+			if _, err := configureRule(fresh, cfg); err != nil {
+				return nil, err
+			}
+			rule = fresh
+		}
+		result = append(result, rule)
 	}
+	return result, nil
+}
 
-	methodName := sel.Sel.Name
-
-	if !isLogMethod(methodName) {
-		return
-	}
+type ruleExecutor struct {
+	rules []rules.Rule
+	pass  *analysis.Pass
+	// stdLog makes every rule check calls of the standard log package, not
+	// only the rules implementing rules.StdLogRule.
+	stdLog bool
+	// catalog makes the executor collect the log calls of the package into
+	// entries, the result of the loglinter analyzer.
+	catalog bool
+	entries []catalog.Entry
+}
 
-	if !isLogger(pass, sel.X) {
-		return
+func newRuleExecutor(configured []rules.Rule, pass *analysis.Pass, cfg rulesConfig) (*ruleExecutor, error) {
+	allRules, err := packageRules(configured, cfg)
+	if err != nil {
+		return nil, err
 	}
-
-	executor.execute(node)
+	return &ruleExecutor{
+		rules:  allRules,
+		pass:   pass,
+		stdLog: cfg.StdLog,
+	}, nil
 }
 
-func isLogger(pass *analysis.Pass, expr ast.Expr) bool {
-	ident := selToIdent(expr)
-	if ident == nil {
-		return false
+// executeAll checks the log calls found by the logcall analyzer.
+func (e *ruleExecutor) executeAll() {
+	for _, call := range e.pass.ResultOf[logcall.Analyzer].([]logcall.LogCall) {
+		e.execute(call)
 	}
+}
 
-	obj := pass.TypesInfo.ObjectOf(ident)
-	if obj == nil {
-		return false
+func (e *ruleExecutor) execute(call logcall.LogCall) {
+	ctx := &rules.CheckContext{
+		MsgExpr:      call.MsgExpr,
+		Msg:          extractStringValue(call.MsgExpr),
+		Constant:     call.Constant,
+		ConstMsg:     call.ConstMsg,
+		Segments:     messageSegments(e.pass, call.MsgExpr),
+		Format:       call.Format,
+		Call:         call.Call,
+		Func:         call.Func,
+		Backend:      call.Backend,
+		Method:       call.Method,
+		Level:        call.Level,
+		PackageLevel: call.PackageLevel,
+		Pass:         e.pass,
+		TypesInfo:    e.pass.TypesInfo,
+		File:         call.Stack[0].(*ast.File),
+		Package:      e.pass.Pkg,
+		Stack:        call.Stack,
+		Args:         call.Args,
+		ChainArgs:    call.ChainArgs,
+		KeyValues:    call.KeyValues,
 	}
-
-	typ := obj.Type()
-	if typ == nil {
-		return false
+	ctx.Attrs, _ = rules.ParseAttrs(ctx.TypesInfo, slices.Concat(ctx.ChainArgs, ctx.Args), ctx.KeyValues)
+	if e.catalog && ctx.MsgExpr != nil {
+		e.entries = append(e.entries, e.catalogEntry(ctx))
 	}
 
-	if ptr, ok := typ.(*types.Pointer); ok {
-		if named, ok := ptr.Elem().(*types.Named); ok {
-			pkg := named.Obj().Pkg()
-			if pkg != nil && pkg.Path() == zapPackage {
-				return named.Obj().Name() == "Logger"
-			}
+	for _, rule := range e.rules {
+		if ctx.Backend == rules.BackendLog && !e.stdLog && !checksStdLog(rule) {
+			continue
+		}
+		ctx.SetReporter(func(result *rules.RuleResult) {
+			e.reportViolation(rule, resultExpr(ctx, result), result)
+		})
+		if result := rule.Check(ctx); !result.Passed {
+			e.reportViolation(rule, resultExpr(ctx, result), result)
 		}
 	}
+}
 
-	if pkgName, ok := obj.(*types.PkgName); ok {
-		return pkgName.Imported().Path() == slogPackage
-	}
-
-	return false
+func checksStdLog(rule rules.Rule) bool {
+	sr, ok := rule.(rules.StdLogRule)
+	return ok && sr.ChecksStdLog()
 }
 
-func selToIdent(expr ast.Expr) *ast.Ident {
-	switch v := expr.(type) {
-	case *ast.Ident:
-		return v
-	case *ast.SelectorExpr:
-		if ident, ok := v.X.(*ast.Ident); ok {
-			return ident
+func (e *ruleExecutor) finish() {
+	for _, rule := range e.rules {
+		if fr, ok := rule.(rules.FinishRule); ok {
+			fr.Finish(e.pass)
 		}
 	}
-	return nil
 }
 
-func isLogMethod(methodName string) bool {
-	allMethods := []string{}
-	for _, methods := range logMethods {
-		allMethods = append(allMethods, methods...)
+// catalogEntry describes a log call for the message catalog.
+func (e *ruleExecutor) catalogEntry(ctx *rules.CheckContext) catalog.Entry {
+	pos := e.pass.Fset.Position(ctx.Call.Pos())
+	entry := catalog.Entry{
+		Package:  e.pass.Pkg.Path(),
+		File:     pos.Filename,
+		Line:     pos.Line,
+		EndLine:  e.pass.Fset.Position(ctx.Call.End()).Line,
+		Logger:   string(ctx.Backend),
+		Level:    ctx.Level.String(),
+		Function: enclosingFuncName(ctx.Stack),
 	}
-	return slices.Contains(allMethods, methodName)
-}
 
-type ruleExecutor struct {
-	rules []rules.Rule
-	pass  *analysis.Pass
-}
+	if ctx.Constant {
+		entry.Message = ctx.ConstMsg
+	} else {
+		entry.Expr = getExprText(e.pass, ctx.MsgExpr)
+	}
 
-func newRuleExecutor(allRules []rules.Rule, pass *analysis.Pass) *ruleExecutor {
-	return &ruleExecutor{
-		rules: allRules,
-		pass:  pass,
+	for _, attr := range ctx.Attrs {
+		if attr.Key != "" && !slices.Contains(entry.Keys, attr.Key) {
+			entry.Keys = append(entry.Keys, attr.Key)
+		}
 	}
+	return entry
 }
 
-func (e *ruleExecutor) execute(call *ast.CallExpr) {
-	if len(call.Args) == 0 {
-		return
+// enclosingFuncName returns the name of the function declaration containing
+// the last node of stack.
+func enclosingFuncName(stack []ast.Node) string {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if decl, ok := stack[i].(*ast.FuncDecl); ok {
+			return FuncName(decl)
+		}
 	}
+	return ""
+}
 
-	msgExpr := call.Args[0]
-	msgValue := extractStringValue(msgExpr)
+// FuncName returns the name of a function declaration, with its receiver
+// type for methods: "(*Server).Run".
+func FuncName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return decl.Name.Name
+	}
 
-	ctx := &rules.CheckContext{
-		MsgExpr: msgExpr,
-		Msg:     msgValue,
+	recv := decl.Recv.List[0].Type
+	star, pointer := recv.(*ast.StarExpr)
+	if pointer {
+		recv = star.X
+	}
+	switch generic := recv.(type) {
+	case *ast.IndexExpr:
+		recv = generic.X
+	case *ast.IndexListExpr:
+		recv = generic.X
 	}
+	if pointer {
+		return "(*" + types.ExprString(recv) + ")." + decl.Name.Name
+	}
+	return types.ExprString(recv) + "." + decl.Name.Name
+}
 
-	for _, rule := range e.rules {
-		if result := rule.Check(ctx); !result.Passed {
-			e.reportViolation(msgExpr, result)
-		}
+// resultExpr returns the expression a failed check should be reported at.
+func resultExpr(ctx *rules.CheckContext, result *rules.RuleResult) ast.Expr {
+	if result.Expr != nil {
+		return result.Expr
 	}
+	if ctx.MsgExpr != nil {
+		return ctx.MsgExpr
+	}
+	return ctx.Call
 }
 
 func isStringLiteral(expr ast.Expr) bool {
@@ -230,28 +353,30 @@ func isStringLiteral(expr ast.Expr) bool {
 	return ok
 }
 
-func (e *ruleExecutor) reportViolation(expr ast.Expr, result *rules.RuleResult) {
+func (e *ruleExecutor) reportViolation(rule rules.Rule, expr ast.Expr, result *rules.RuleResult) {
 	diag := analysis.Diagnostic{
-		Pos:     expr.Pos(),
-		End:     expr.End(),
-		Message: result.Message,
+		Pos:      expr.Pos(),
+		End:      expr.End(),
+		Category: rule.Name(),
+		Message:  result.Message,
+	}
+	if result.Pos.IsValid() {
+		diag.Pos, diag.End = result.Pos, result.End
+	}
+
+	for _, related := range result.Related {
+		diag.Related = append(diag.Related, analysis.RelatedInformation{
+			Pos:     related.Node.Pos(),
+			End:     related.Node.End(),
+			Message: related.Message,
+		})
 	}
 
 	if result.SuggestedFix != nil {
-		newText := result.SuggestedFix.NewText
-		if isStringLiteral(expr) {
-			newText = `"` + newText + `"`
-		}
 		diag.SuggestedFixes = []analysis.SuggestedFix{
 			{
-				Message: result.SuggestedFix.Message,
-				TextEdits: []analysis.TextEdit{
-					{
-						Pos:     expr.Pos(),
-						End:     expr.End(),
-						NewText: []byte(newText),
-					},
-				},
+				Message:   result.SuggestedFix.Message,
+				TextEdits: fixEdits(expr, result.SuggestedFix),
 			},
 		}
 	}
@@ -259,6 +384,58 @@ func (e *ruleExecutor) reportViolation(expr ast.Expr, result *rules.RuleResult)
 	e.pass.Report(diag)
 }
 
+func fixEdits(expr ast.Expr, fix *rules.SuggestedFix) []analysis.TextEdit {
+	if len(fix.Edits) > 0 {
+		edits := make([]analysis.TextEdit, 0, len(fix.Edits))
+		for _, edit := range fix.Edits {
+			edits = append(edits, analysis.TextEdit{
+				Pos:     edit.Pos,
+				End:     edit.End,
+				NewText: []byte(edit.NewText),
+			})
+		}
+		return edits
+	}
+
+	newText := fix.NewText
+	if isStringLiteral(expr) {
+		newText = `"` + newText + `"`
+	}
+	return []analysis.TextEdit{
+		{
+			Pos:     expr.Pos(),
+			End:     expr.End(),
+			NewText: []byte(newText),
+		},
+	}
+}
+
+// messageSegments returns the string literals of the message. Raw strings
+// spanning lines get their source text, since the parser drops the carriage
+// returns of CRLF files from their value.
+func messageSegments(pass *analysis.Pass, expr ast.Expr) []rules.Segment {
+	segments := rules.MessageSegments(expr)
+	for i, seg := range segments {
+		if seg.Lit.Value[0] != '`' || !strings.Contains(seg.Lit.Value, "\n") {
+			continue
+		}
+		file := pass.Fset.File(seg.Pos())
+		if file == nil {
+			continue
+		}
+		src, err := pass.ReadFile(file.Name())
+		if err != nil {
+			continue
+		}
+
+		start := file.Offset(seg.Pos())
+		if end := strings.IndexByte(string(src[start+1:]), '`'); end >= 0 {
+			segments[i].Src = string(src[start : start+end+2])
+		}
+	}
+	return segments
+}
+
 func getExprText(pass *analysis.Pass, expr ast.Expr) string {
 	file := pass.Fset.File(expr.Pos())
 	if file == nil {
@@ -1,16 +1,441 @@
 package analyzer_test
 
 import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
 
 	"github.com/demidshumakher/loglinter/pkg/analyzer"
+	"github.com/demidshumakher/loglinter/pkg/catalog"
+	"github.com/demidshumakher/loglinter/pkg/rules"
 )
 
+// personalDataRule is a rule registered the way rules of other modules are.
+// It reports every attribute whose key starts with "user".
+type personalDataRule struct {
+	rules.BaseRule
+}
+
+func newPersonalDataRule() rules.Rule {
+	rule := &personalDataRule{BaseRule: rules.NewBaseRule("personal_data", "Checks that log calls do not log personal data")}
+	rule.SetEnabled(false)
+	return rule
+}
+
+func (r *personalDataRule) Check(ctx *rules.CheckContext) *rules.RuleResult {
+	for _, attr := range ctx.Attrs {
+		// Attributes of With calls are checked at the With call itself.
+		if slices.Contains(ctx.ChainArgs, attr.Arg) {
+			continue
+		}
+		if strings.HasPrefix(attr.Key, "user") {
+			ctx.Report(rules.ResultFailAt(attr.KeyExpr, fmt.Sprintf("attribute key %q is personal data", attr.Key)))
+		}
+	}
+	return rules.ResultPass()
+}
+
+// configureCountRule counts how many times the analyzers configure it.
+type configureCountRule struct {
+	rules.BaseRule
+}
+
+var configureCount atomic.Int32
+
+func newConfigureCountRule() rules.Rule {
+	rule := &configureCountRule{BaseRule: rules.NewBaseRule("configure_count", "Counts how many times it is configured")}
+	rule.SetEnabled(false)
+	return rule
+}
+
+func (r *configureCountRule) Configure(config map[string]any) error {
+	configureCount.Add(1)
+	return r.BaseRule.Configure(config)
+}
+
+func (r *configureCountRule) Check(*rules.CheckContext) *rules.RuleResult {
+	return rules.ResultPass()
+}
+
+func init() {
+	if err := rules.RegisterRule("personal_data", newPersonalDataRule); err != nil {
+		panic(err)
+	}
+	if err := rules.RegisterRule("configure_count", newConfigureCountRule); err != nil {
+		panic(err)
+	}
+}
+
 func TestAnalyzer(t *testing.T) {
 	testdata := analysistest.TestData()
-	analyzer := analyzer.Analyzer(nil)
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"error_attr": map[string]any{"enabled": false},
+		},
+	})
 
 	analysistest.Run(t, testdata, analyzer, "example")
 }
+
+func TestCustomRule(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"personal_data": map[string]any{"enabled": true},
+		},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "customrule")
+}
+
+func TestSpecialChars(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{"check_std_log": true})
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "specialchars")
+}
+
+func TestStdLog(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(nil)
+
+	analysistest.Run(t, testdata, analyzer, "stdlog")
+}
+
+func TestSensitiveWords(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(nil)
+
+	analysistest.Run(t, testdata, analyzer, "sensitivewords")
+}
+
+func TestRuleAnalyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	a, err := analyzer.RuleAnalyzer(nil, "lowercase")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, a, "perrule")
+
+	if _, err := analyzer.RuleAnalyzer(nil, "no_such_rule"); err == nil {
+		t.Error("RuleAnalyzer returned no error for an unknown rule")
+	}
+}
+
+func TestAnalyzersPerRule(t *testing.T) {
+	analyzers, err := analyzer.Analyzers(map[string]any{
+		"analyzer_per_rule": true,
+		"rules": map[string]any{
+			"lowercase":       map[string]any{"enabled": false},
+			"unique_messages": map[string]any{"enabled": true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := analysis.Validate(analyzers); err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, a := range analyzers {
+		names = append(names, a.Name)
+	}
+	if slices.Contains(names, "loglinter_lowercase") {
+		t.Errorf("analyzers %v include the disabled rule lowercase", names)
+	}
+	if !slices.Contains(names, "loglinter_unique_messages") || !slices.Contains(names, "loglinter_key_types") {
+		t.Errorf("analyzers %v miss enabled rules", names)
+	}
+}
+
+func TestConfigureOnce(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"configure_count": map[string]any{"enabled": true, "option": 1},
+			"unique_messages": map[string]any{
+				"enabled":       true,
+				"min_length":    3,
+				"ignore":        []any{"Done"},
+				"ignore_levels": []any{"debug"},
+			},
+		},
+	})
+
+	configureCount.Store(0)
+	analysistest.Run(t, testdata, analyzer, "uniquemessages/...")
+	if n := configureCount.Load(); n != 1 {
+		t.Errorf("the rule was configured %d times, want once per analyzer", n)
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	cfg := map[string]any{
+		"rules": map[string]any{
+			"attr_keys": map[string]any{"enabled": true, "style": "pascal"},
+		},
+	}
+	if _, err := analyzer.Analyzers(cfg); err == nil || !strings.Contains(err.Error(), "attr_keys") {
+		t.Errorf("Analyzers() returned %v, want the attr_keys error", err)
+	}
+	cfg["analyzer_per_rule"] = true
+	if _, err := analyzer.Analyzers(cfg); err == nil {
+		t.Error("Analyzers() with analyzer_per_rule returned no error")
+	}
+	if _, err := analyzer.RuleAnalyzer(cfg, "attr_keys"); err == nil {
+		t.Error("RuleAnalyzer() returned no error")
+	}
+	if _, err := analyzer.EnabledRules(cfg); err == nil {
+		t.Error("EnabledRules() returned no error")
+	}
+}
+
+func TestKVPairs(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(nil)
+
+	analysistest.Run(t, testdata, analyzer, "kvpairs")
+}
+
+func TestAttrKeys(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"attr_keys": map[string]any{"enabled": true},
+		},
+	})
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "attrkeys")
+}
+
+func TestKeyTypes(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(nil)
+
+	analysistest.Run(t, testdata, analyzer, "keytypes")
+}
+
+func TestKeySchema(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"key_types": map[string]any{
+				"schema": filepath.Join(testdata, "src", "keyschema", "schema.yaml"),
+			},
+		},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "keyschema")
+}
+
+func TestOTelSemconv(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"otel_semconv": map[string]any{"enabled": true},
+			"attr_keys":    map[string]any{"enabled": false},
+			"key_types":    map[string]any{"enabled": false},
+		},
+	})
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "semconv")
+}
+
+func TestErrorAttr(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"fatal_in_main": map[string]any{"enabled": false},
+		},
+	})
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "errorattr")
+}
+
+func TestLogAndReturn(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(nil)
+
+	results := analysistest.Run(t, testdata, analyzer, "logreturn")
+	checked := 0
+	for _, result := range results {
+		fset := result.Pass.Fset
+		for _, diag := range result.Diagnostics {
+			if diag.Category != "log_and_return" {
+				continue
+			}
+			checked++
+			// Every return in the test data follows the log call.
+			if len(diag.Related) != 1 {
+				t.Errorf("%s: got %d related locations, want 1", fset.Position(diag.Pos), len(diag.Related))
+				continue
+			}
+			related := diag.Related[0]
+			if fset.Position(related.Pos).Line != fset.Position(diag.Pos).Line+1 || related.Message != "err is returned here" {
+				t.Errorf("%s: related %s %q, want the return statement", fset.Position(diag.Pos), fset.Position(related.Pos), related.Message)
+			}
+		}
+	}
+	if checked != 3 {
+		t.Errorf("checked %d log_and_return diagnostics, want 3", checked)
+	}
+}
+
+func TestNoErrorString(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(nil)
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "errorstring")
+}
+
+func TestUseContext(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"use_context": map[string]any{"enabled": true},
+		},
+	})
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "usecontext")
+}
+
+func TestNoGlobalLogger(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"no_global_logger": map[string]any{
+				"enabled": true,
+				"packages": []any{
+					"globallogger/lib/...",
+					map[string]any{"pattern": "globallogger/internal/*", "logger": "store.Logger"},
+				},
+			},
+		},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "globallogger/...")
+}
+
+func TestFatalInMain(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"fatal_in_main": map[string]any{
+				"allow": []any{"fatal/tools"},
+			},
+		},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "fatal/...")
+}
+
+func TestLevelPolicy(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"level_policy": map[string]any{
+				"enabled": true,
+				"policies": []any{
+					map[string]any{"packages": []any{"levelpolicy/cmd/..."}, "deny": []any{"debug"}},
+					map[string]any{"files": []any{"*_test.go"}, "deny": []any{"error"}},
+					map[string]any{"packages": []any{"levelpolicy/dataplane"}, "max": "info"},
+				},
+			},
+		},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "levelpolicy/...")
+}
+
+func TestLoopLogging(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"loop_logging": map[string]any{
+				"enabled":      true,
+				"allow_debug":  false,
+				"allow_levels": []any{"warn"},
+			},
+		},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "looplogging")
+}
+
+func TestUniqueMessages(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"unique_messages": map[string]any{
+				"enabled":       true,
+				"min_length":    3.0, // decoded from JSON
+				"ignore":        []any{"Done"},
+				"ignore_levels": []any{"debug"},
+			},
+		},
+	})
+
+	results := analysistest.Run(t, testdata, analyzer, "uniquemessages/...")
+	for _, result := range results {
+		for _, diag := range result.Diagnostics {
+			if !strings.Contains(diag.Message, "uniquemessages/store/store.go:6") {
+				continue
+			}
+			if len(diag.Related) != 1 {
+				t.Fatalf("got %d related locations, want the imported one", len(diag.Related))
+			}
+			related := result.Pass.Fset.Position(diag.Related[0].Pos)
+			if filepath.Base(related.Filename) != "store.go" || related.Line != 6 {
+				t.Errorf("related location %s, want store.go:6", related)
+			}
+			return
+		}
+	}
+	t.Error("no diagnostic for the message logged by an imported package")
+}
+
+func TestCatalog(t *testing.T) {
+	testdata := analysistest.TestData()
+	results := analysistest.Run(t, testdata, analyzer.Analyzer(nil), "catalogexport")
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+
+	entries := results[0].Result.([]catalog.Entry)
+	for i := range entries {
+		entries[i].File = filepath.Base(entries[i].File)
+	}
+	want := []catalog.Entry{
+		{Package: "catalogexport", File: "catalogexport.go", Line: 17, EndLine: 17, Logger: "zap", Level: "info", Message: "server started", Keys: []string{"user_id", "attempt"}, Function: "(*Server).Start"},
+		{Package: "catalogexport", File: "catalogexport.go", Line: 21, EndLine: 21, Logger: "slog", Level: "warn", Expr: `"serving "+name`, Keys: []string{"user_id"}, Function: "Serve"},
+		{Package: "catalogexport", File: "catalogexport.go", Line: 22, EndLine: 22, Logger: "log", Level: "info", Message: "listening on %s", Function: "Serve"},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("catalog entries:\n got %+v\nwant %+v", entries, want)
+	}
+}
+
+func TestCatalogRule(t *testing.T) {
+	testdata := analysistest.TestData()
+	analyzer := analyzer.Analyzer(map[string]any{
+		"rules": map[string]any{
+			"catalog": map[string]any{
+				"enabled":          true,
+				"path":             filepath.Join(testdata, "src", "catalogrule", "catalog.yaml"),
+				"require_event_id": true,
+				"report_unused":    true,
+			},
+		},
+	})
+
+	analysistest.Run(t, testdata, analyzer, "catalogrule/...")
+}
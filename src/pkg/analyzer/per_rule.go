@@ -0,0 +1,88 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/ctrlflow"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+
+	"github.com/demidshumakher/loglinter/pkg/logcall"
+	"github.com/demidshumakher/loglinter/pkg/rules"
+)
+
+// RuleAnalyzerPrefix starts the names of the analyzers of single rules.
+const RuleAnalyzerPrefix = "loglinter_"
+
+// Analyzers returns the analyzers configured by cfg: the loglinter analyzer,
+// or with analyzer_per_rule set, an analyzer for every enabled rule. The
+// rules are built and configured here, so an invalid configuration is
+// reported before any package is checked.
+func Analyzers(cfg any) ([]*analysis.Analyzer, error) {
+	config := parseConfig(cfg)
+	configured, err := getRules(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if !config.PerRule {
+		return []*analysis.Analyzer{newAnalyzer(config, func() ([]rules.Rule, error) {
+			return configured, nil
+		})}, nil
+	}
+
+	analyzers := make([]*analysis.Analyzer, 0, len(configured))
+	for _, rule := range configured {
+		analyzers = append(analyzers, ruleAnalyzer(config, rule, true))
+	}
+	return analyzers, nil
+}
+
+// RuleAnalyzer returns an analyzer running only the named rule, configured
+// by cfg. It is named after the rule, loglinter_lowercase for lowercase, and
+// shares log call discovery with the other analyzers of a run.
+func RuleAnalyzer(cfg any, name string) (*analysis.Analyzer, error) {
+	rule, err := rules.GetRule(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build analyzer: %w", err)
+	}
+
+	config := parseConfig(cfg)
+	enabled, err := configureRule(rule, config)
+	if err != nil {
+		return nil, err
+	}
+	return ruleAnalyzer(config, rule, enabled), nil
+}
+
+func ruleAnalyzer(config rulesConfig, rule rules.Rule, enabled bool) *analysis.Analyzer {
+	var facts []analysis.Fact
+	if pr, ok := rule.(rules.PackageRule); ok {
+		facts = pr.FactTypes()
+	}
+
+	return &analysis.Analyzer{
+		Name:      RuleAnalyzerPrefix + rule.Name(),
+		Doc:       rule.Description(),
+		Run:       makeRuleRunFunc(config, rule, enabled),
+		Requires:  []*analysis.Analyzer{logcall.Analyzer, inspect.Analyzer, ctrlflow.Analyzer},
+		FactTypes: facts,
+	}
+}
+
+func makeRuleRunFunc(config rulesConfig, rule rules.Rule, enabled bool) func(*analysis.Pass) (interface{}, error) {
+	var configured []rules.Rule
+	if enabled {
+		configured = []rules.Rule{rule}
+	}
+
+	return func(pass *analysis.Pass) (interface{}, error) {
+		executor, err := newRuleExecutor(configured, pass, config)
+		if err != nil {
+			return nil, err
+		}
+		executor.executeAll()
+		executor.finish()
+		return nil, nil
+	}
+}
@@ -0,0 +1,131 @@
+// Package baseline records known findings so that only new ones are
+// reported. Findings are matched by rule, package, enclosing function and
+// normalized message, never by line, so unrelated edits do not invalidate
+// the baseline.
+package baseline
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/demidshumakher/loglinter/pkg/report"
+)
+
+const version = 1
+
+// Baseline is the content of a baseline file.
+type Baseline struct {
+	Version int     `json:"version"`
+	Entries []Entry `json:"entries"`
+}
+
+// Entry is a group of identical findings.
+type Entry struct {
+	Rule     string `json:"rule"`
+	Package  string `json:"package"`
+	Function string `json:"function"`
+	Message  string `json:"message"`
+	Count    int    `json:"count"`
+}
+
+type key struct {
+	rule, pkg, function, message string
+}
+
+func (e Entry) key() key {
+	return key{e.Rule, e.Package, e.Function, e.Message}
+}
+
+func keyOf(f report.Finding) key {
+	return key{f.RuleID, f.Package, f.Function, NormalizeMessage(f.Message)}
+}
+
+// positionRe matches file positions quoted in messages, such as app.go:12 or
+// app.go:12:5.
+var positionRe = regexp.MustCompile(`(\.go):\d+(:\d+)?`)
+
+// NormalizeMessage removes line numbers and extra spaces from a message.
+func NormalizeMessage(msg string) string {
+	msg = positionRe.ReplaceAllString(msg, "$1")
+	return strings.Join(strings.Fields(msg), " ")
+}
+
+// New builds a baseline from findings.
+func New(findings []report.Finding) *Baseline {
+	counts := make(map[key]int)
+	for _, f := range findings {
+		counts[keyOf(f)]++
+	}
+
+	b := &Baseline{Version: version, Entries: make([]Entry, 0, len(counts))}
+	for k, count := range counts {
+		b.Entries = append(b.Entries, Entry{Rule: k.rule, Package: k.pkg, Function: k.function, Message: k.message, Count: count})
+	}
+	slices.SortFunc(b.Entries, func(a, b Entry) int {
+		return cmp.Or(
+			cmp.Compare(a.Package, b.Package),
+			cmp.Compare(a.Function, b.Function),
+			cmp.Compare(a.Rule, b.Rule),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+	return b
+}
+
+// Load reads a baseline file.
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+	if b.Version != version {
+		return nil, fmt.Errorf("baseline %s has version %d, want %d", path, b.Version, version)
+	}
+	return &b, nil
+}
+
+// Write saves the baseline to path.
+func (b *Baseline) Write(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Filter returns the findings not covered by the baseline and the baseline
+// entries, with their remaining counts, that no finding matched anymore.
+func (b *Baseline) Filter(findings []report.Finding) (fresh []report.Finding, fixed []Entry) {
+	remaining := make(map[key]int, len(b.Entries))
+	for _, e := range b.Entries {
+		remaining[e.key()] += e.Count
+	}
+
+	for _, f := range findings {
+		k := keyOf(f)
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		fresh = append(fresh, f)
+	}
+
+	for _, e := range b.Entries {
+		if count := remaining[e.key()]; count > 0 {
+			e.Count = count
+			fixed = append(fixed, e)
+			remaining[e.key()] = 0
+		}
+	}
+	return fresh, fixed
+}
@@ -0,0 +1,68 @@
+package baseline
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/demidshumakher/loglinter/pkg/report"
+)
+
+func finding(rule, function, message string, line int) report.Finding {
+	return report.Finding{
+		RuleID:   rule,
+		Package:  "example.com/app",
+		Function: function,
+		Message:  message,
+		Pos:      report.Position{File: "app.go", Line: line, Column: 2},
+	}
+}
+
+func TestNormalizeMessage(t *testing.T) {
+	got := NormalizeMessage(`log key "user_id" is logged as int here and as string at  store.go:12:4`)
+	want := `log key "user_id" is logged as int here and as string at store.go`
+	if got != want {
+		t.Errorf("NormalizeMessage = %q, want %q", got, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	b := New([]report.Finding{
+		finding("lowercase", "main", "log message should start with a lowercase letter", 10),
+		finding("lowercase", "main", "log message should start with a lowercase letter", 12),
+		finding("loop_logging", "(*Worker).Run", "info log call inside a loop, sample it or move it out of the loop", 30),
+		finding("key_types", "main", "log key \"id\" is logged as int here and as string at app.go:3", 14),
+	})
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := b.Write(path); err != nil {
+		t.Fatal(err)
+	}
+	b, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Lines moved, one lowercase finding was fixed, a new one appeared in
+	// another function.
+	fresh, fixed := b.Filter([]report.Finding{
+		finding("lowercase", "main", "log message should start with a lowercase letter", 20),
+		finding("lowercase", "serve", "log message should start with a lowercase letter", 40),
+		finding("loop_logging", "(*Worker).Run", "info log call inside a loop, sample it or move it out of the loop", 35),
+		finding("key_types", "main", "log key \"id\" is logged as int here and as string at app.go:5", 16),
+	})
+
+	if len(fresh) != 1 || fresh[0].Function != "serve" {
+		t.Errorf("fresh = %+v, want the finding in serve", fresh)
+	}
+	wantFixed := []Entry{{
+		Rule:     "lowercase",
+		Package:  "example.com/app",
+		Function: "main",
+		Message:  "log message should start with a lowercase letter",
+		Count:    1,
+	}}
+	if !reflect.DeepEqual(fixed, wantFixed) {
+		t.Errorf("fixed = %+v, want %+v", fixed, wantFixed)
+	}
+}
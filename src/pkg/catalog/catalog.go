@@ -0,0 +1,72 @@
+// Package catalog describes the log calls found by the analyzer and writes
+// them as JSON Lines or CSV for review outside the linter.
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Entry is a log call found in the analyzed code.
+type Entry struct {
+	Package string `json:"package"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	// EndLine is the last line of the call.
+	EndLine int    `json:"end_line"`
+	Logger  string `json:"logger"`
+	Level   string `json:"level"`
+	// Message is the constant message text. When the message is not a
+	// constant, Expr holds its source instead.
+	Message  string   `json:"message,omitempty"`
+	Expr     string   `json:"expr,omitempty"`
+	Keys     []string `json:"keys"`
+	Function string   `json:"function"`
+}
+
+var csvHeader = []string{"package", "file", "line", "end_line", "logger", "level", "message", "expr", "keys", "function"}
+
+// WriteJSONLines writes one JSON object per entry.
+func WriteJSONLines(w io.Writer, entries []Entry) error {
+	enc := json.NewEncoder(w)
+	for _, entry := range entries {
+		if entry.Keys == nil {
+			entry.Keys = []string{}
+		}
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes the entries as CSV with a header row. Keys are joined with
+// semicolons.
+func WriteCSV(w io.Writer, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		record := []string{
+			entry.Package,
+			entry.File,
+			strconv.Itoa(entry.Line),
+			strconv.Itoa(entry.EndLine),
+			entry.Logger,
+			entry.Level,
+			entry.Message,
+			entry.Expr,
+			strings.Join(entry.Keys, ";"),
+			entry.Function,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"bytes"
+	"testing"
+)
+
+var testEntries = []Entry{
+	{Package: "example.com/app", File: "main.go", Line: 12, EndLine: 12, Logger: "slog", Level: "info", Message: "server started", Keys: []string{"addr", "port"}, Function: "main"},
+	{Package: "example.com/app/store", File: "store.go", Line: 40, EndLine: 42, Logger: "zap", Level: "error", Expr: `"query " + name`, Function: "(*Store).Query"},
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSONLines(&buf, testEntries); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"package":"example.com/app","file":"main.go","line":12,"end_line":12,"logger":"slog","level":"info","message":"server started","keys":["addr","port"],"function":"main"}
+{"package":"example.com/app/store","file":"store.go","line":40,"end_line":42,"logger":"zap","level":"error","expr":"\"query \" + name","keys":[],"function":"(*Store).Query"}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteJSONLines:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, testEntries); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `package,file,line,end_line,logger,level,message,expr,keys,function
+example.com/app,main.go,12,12,slog,info,server started,,addr;port,main
+example.com/app/store,store.go,40,42,zap,error,,"""query "" + name",,(*Store).Query
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteCSV:\n got %s\nwant %s", got, want)
+	}
+}
@@ -0,0 +1,132 @@
+// Package diff reads the changed lines of files from a unified diff.
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Range is an inclusive range of lines.
+type Range struct {
+	Start, End int
+}
+
+// Changes maps file paths, as written in the diff, to the ranges of lines
+// added or modified in the new version of the file.
+type Changes map[string][]Range
+
+var hunkRe = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// Parse reads a unified diff, as produced by git diff or diff -u. Lines
+// removed without replacement leave no changed lines.
+func Parse(r io.Reader) (Changes, error) {
+	changes := make(Changes)
+	var file string
+	// line is the next line of the new file, oldLeft and newLeft count the
+	// lines of the current hunk still to be read.
+	var line, oldLeft, newLeft int
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if oldLeft > 0 || newLeft > 0 {
+			switch {
+			case strings.HasPrefix(text, "+"):
+				changes.add(file, line)
+				line++
+				newLeft--
+			case strings.HasPrefix(text, "-"):
+				oldLeft--
+			case strings.HasPrefix(text, `\`):
+			default:
+				// A context line, its leading space may be trimmed.
+				line++
+				oldLeft--
+				newLeft--
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			file = newFileName(text[len("+++ "):])
+		case strings.HasPrefix(text, "@@ "):
+			m := hunkRe.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("malformed hunk header %q", text)
+			}
+			oldLeft = hunkCount(m[1])
+			line, _ = strconv.Atoi(m[2])
+			newLeft = hunkCount(m[3])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func hunkCount(s string) int {
+	if s == "" {
+		return 1
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// newFileName returns the path of the new file from a "+++" line, without
+// the b/ prefix of git and the timestamp of diff -u. Deleted files have an
+// empty name.
+func newFileName(name string) string {
+	if i := strings.IndexByte(name, '\t'); i >= 0 {
+		name = name[:i]
+	}
+	if name == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(name, "b/")
+}
+
+func (c Changes) add(file string, line int) {
+	if file == "" {
+		return
+	}
+	ranges := c[file]
+	if n := len(ranges); n > 0 && ranges[n-1].End == line-1 {
+		ranges[n-1].End = line
+		return
+	}
+	c[file] = append(ranges, Range{Start: line, End: line})
+}
+
+// AddFile marks every line of a new file as changed.
+func (c Changes) AddFile(file string) {
+	c[file] = []Range{{Start: 1, End: math.MaxInt}}
+}
+
+// Rebase returns the changes with relative file paths joined to root.
+func (c Changes) Rebase(root string) Changes {
+	rebased := make(Changes, len(c))
+	for file, ranges := range c {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(root, filepath.FromSlash(file))
+		}
+		rebased[file] = ranges
+	}
+	return rebased
+}
+
+// Overlaps reports whether any line from start to end of file changed.
+func (c Changes) Overlaps(file string, start, end int) bool {
+	return slices.ContainsFunc(c[file], func(r Range) bool {
+		return r.Start <= end && start <= r.End
+	})
+}
@@ -0,0 +1,78 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const gitDiff = `diff --git a/app/main.go b/app/main.go
+index 3b18e51..a9c4b2e 100644
+--- a/app/main.go
++++ b/app/main.go
+@@ -10,2 +10,3 @@ func main() {
+ 	slog.Info("starting")
+-	slog.Info("Ready")
++	slog.Info("ready")
++	slog.Info("serving")
+@@ -30 +31,0 @@ func main() {
+-	slog.Debug("done")
+@@ -40,0 +41 @@ func stop() {
++-- not a file header
+diff --git a/old.go b/old.go
+deleted file mode 100644
+--- a/old.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package old
+diff --git a/store/store.go b/store/store.go
+new file mode 100644
+--- /dev/null
++++ b/store/store.go
+@@ -0,0 +1,2 @@
++package store
++
+`
+
+func TestParse(t *testing.T) {
+	changes, err := Parse(strings.NewReader(gitDiff))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Changes{
+		"app/main.go":    {{Start: 11, End: 12}, {Start: 41, End: 41}},
+		"store/store.go": {{Start: 1, End: 2}},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("Parse = %v, want %v", changes, want)
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	changes := Changes{"/src/main.go": {{Start: 11, End: 12}}}.Rebase("/repo")
+	if changes.Overlaps("/repo/src/main.go", 1, 100) {
+		t.Error("absolute paths should not be rebased")
+	}
+
+	changes = Changes{"main.go": {{Start: 11, End: 12}}}.Rebase("/repo")
+	tests := []struct {
+		start, end int
+		want       bool
+	}{
+		{9, 10, false},
+		{10, 11, true},
+		{12, 14, true},
+		{13, 13, false},
+	}
+	for _, tt := range tests {
+		if got := changes.Overlaps("/repo/main.go", tt.start, tt.end); got != tt.want {
+			t.Errorf("Overlaps(%d, %d) = %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+	}
+
+	changes.AddFile("/repo/new.go")
+	if !changes.Overlaps("/repo/new.go", 1000, 1002) {
+		t.Error("lines of an added file should be changed")
+	}
+}
@@ -0,0 +1,88 @@
+package logcall
+
+import "strings"
+
+// Level is a log level normalized across backends.
+type Level int
+
+const (
+	LevelUnknown Level = iota
+	LevelDebug
+	LevelInfo
+	LevelWarn
+	LevelError
+	LevelDPanic
+	LevelPanic
+	LevelFatal
+)
+
+var levelNames = map[Level]string{
+	LevelUnknown: "unknown",
+	LevelDebug:   "debug",
+	LevelInfo:    "info",
+	LevelWarn:    "warn",
+	LevelError:   "error",
+	LevelDPanic:  "dpanic",
+	LevelPanic:   "panic",
+	LevelFatal:   "fatal",
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// ParseLevel returns the level with the given name, ignoring case.
+func ParseLevel(name string) (Level, bool) {
+	name = strings.ToLower(name)
+	for level, levelName := range levelNames {
+		if level != LevelUnknown && levelName == name {
+			return level, true
+		}
+	}
+	return LevelUnknown, false
+}
+
+// MethodLevel returns the level of a log method: Infow, InfoContext and
+// Infof are all LevelInfo. Print methods of the standard log package log at
+// LevelInfo.
+func MethodLevel(method string) Level {
+	for _, suffix := range []string{"Context", "w", "f", "ln"} {
+		if trimmed, ok := strings.CutSuffix(method, suffix); ok && trimmed != "" {
+			if level := MethodLevel(trimmed); level != LevelUnknown {
+				return level
+			}
+		}
+	}
+
+	switch method {
+	case "Debug":
+		return LevelDebug
+	case "Info", "Print":
+		return LevelInfo
+	case "Warn":
+		return LevelWarn
+	case "Error":
+		return LevelError
+	case "DPanic":
+		return LevelDPanic
+	case "Panic":
+		return LevelPanic
+	case "Fatal":
+		return LevelFatal
+	}
+	return LevelUnknown
+}
+
+// SlogLevel maps a slog.Level value to a level the way slog names levels:
+// anything between two named levels belongs to the lower one.
+func SlogLevel(value int64) Level {
+	switch {
+	case value >= 8:
+		return LevelError
+	case value >= 4:
+		return LevelWarn
+	case value >= 0:
+		return LevelInfo
+	}
+	return LevelDebug
+}
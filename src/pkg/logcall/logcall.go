@@ -0,0 +1,309 @@
+// Package logcall finds the calls of slog, zap and log loggers. Its analyzer
+// can be required by other analyzers that check log calls:
+//
+//	var Analyzer = &analysis.Analyzer{
+//		Requires: []*analysis.Analyzer{logcall.Analyzer},
+//		Run: func(pass *analysis.Pass) (interface{}, error) {
+//			for _, call := range pass.ResultOf[logcall.Analyzer].([]logcall.LogCall) {
+//				...
+//			}
+//		},
+//	}
+package logcall
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// Analyzer finds the log calls of a package. Its result is a []LogCall in
+// source order.
+var Analyzer = &analysis.Analyzer{
+	Name:       "logcall",
+	Doc:        "Finds log calls of slog, zap and log",
+	Run:        run,
+	Requires:   []*analysis.Analyzer{inspect.Analyzer},
+	ResultType: reflect.TypeOf([]LogCall(nil)),
+}
+
+// Backend identifies the logging library of a log call.
+type Backend string
+
+const (
+	BackendSlog     Backend = "slog"
+	BackendZap      Backend = "zap"
+	BackendZapSugar Backend = "zap_sugar"
+	BackendLog      Backend = "log"
+)
+
+// LogCall is a call of a log method, or of a With method deriving a logger.
+type LogCall struct {
+	Pos  token.Pos
+	Call *ast.CallExpr
+	// Func is the called function or method, nil if it could not be
+	// resolved.
+	Func    *types.Func
+	Backend Backend
+	Method  string
+	Level   Level
+	// Logger is the receiver of the method: a logger value, or the package
+	// name for package-level functions such as slog.Info.
+	Logger ast.Expr
+	// PackageLevel reports whether the call goes through a package-level
+	// function rather than a logger value.
+	PackageLevel bool
+	// MsgExpr is the message argument, nil for With calls. ConstMsg is its
+	// value when Constant is set.
+	MsgExpr  ast.Expr
+	ConstMsg string
+	Constant bool
+	// Format reports whether the message is a printf-style format string.
+	Format bool
+	// Args are the attribute arguments following the message, ChainArgs the
+	// attribute arguments of the With calls the logger was derived from
+	// within the same expression.
+	Args      []ast.Expr
+	ChainArgs []ast.Expr
+	// KeyValues reports whether attributes are passed as alternating keys
+	// and values rather than as slog.Attr or zap.Field values.
+	KeyValues bool
+	// Stack is the path of nodes from the file down to the call, inclusive.
+	Stack []ast.Node
+}
+
+const (
+	slogPackage = "log/slog"
+	zapPackage  = "go.uber.org/zap"
+	logPackage  = "log"
+)
+
+type loggerKind int
+
+const (
+	loggerNone loggerKind = iota
+	loggerSlog
+	loggerSlogLogger
+	loggerZap
+	loggerZapSugar
+	loggerLog
+	loggerLogLogger
+)
+
+// callLayout describes where the message and the attributes of a log method
+// are placed among the call arguments.
+type callLayout struct {
+	msgIndex  int  // -1 if the method has no message
+	argsIndex int  // index of the first attribute argument, -1 if there are none
+	keyValues bool // attributes are alternating keys and values
+	format    bool // the message is a printf-style format string
+}
+
+var loggerBackends = map[loggerKind]Backend{
+	loggerSlog:       BackendSlog,
+	loggerSlogLogger: BackendSlog,
+	loggerZap:        BackendZap,
+	loggerZapSugar:   BackendZapSugar,
+	loggerLog:        BackendLog,
+	loggerLogLogger:  BackendLog,
+}
+
+var slogMethods = map[string]callLayout{
+	"Debug":        {msgIndex: 0, argsIndex: 1, keyValues: true},
+	"Info":         {msgIndex: 0, argsIndex: 1, keyValues: true},
+	"Warn":         {msgIndex: 0, argsIndex: 1, keyValues: true},
+	"Error":        {msgIndex: 0, argsIndex: 1, keyValues: true},
+	"DebugContext": {msgIndex: 1, argsIndex: 2, keyValues: true},
+	"InfoContext":  {msgIndex: 1, argsIndex: 2, keyValues: true},
+	"WarnContext":  {msgIndex: 1, argsIndex: 2, keyValues: true},
+	"ErrorContext": {msgIndex: 1, argsIndex: 2, keyValues: true},
+	"Log":          {msgIndex: 2, argsIndex: 3, keyValues: true},
+	"LogAttrs":     {msgIndex: 2, argsIndex: 3},
+	"With":         {msgIndex: -1, argsIndex: 0, keyValues: true},
+}
+
+var stdlogMethods = map[string]callLayout{
+	"Print":   {msgIndex: 0, argsIndex: -1},
+	"Printf":  {msgIndex: 0, argsIndex: -1, format: true},
+	"Println": {msgIndex: 0, argsIndex: -1},
+	"Fatal":   {msgIndex: 0, argsIndex: -1},
+	"Fatalf":  {msgIndex: 0, argsIndex: -1, format: true},
+	"Fatalln": {msgIndex: 0, argsIndex: -1},
+	"Panic":   {msgIndex: 0, argsIndex: -1},
+	"Panicf":  {msgIndex: 0, argsIndex: -1, format: true},
+	"Panicln": {msgIndex: 0, argsIndex: -1},
+}
+
+var logMethods = map[loggerKind]map[string]callLayout{
+	loggerSlog:       slogMethods,
+	loggerSlogLogger: slogMethods,
+	loggerLog:        stdlogMethods,
+	loggerLogLogger:  stdlogMethods,
+	loggerZap: {
+		"Debug":  {msgIndex: 0, argsIndex: 1},
+		"Info":   {msgIndex: 0, argsIndex: 1},
+		"Warn":   {msgIndex: 0, argsIndex: 1},
+		"Error":  {msgIndex: 0, argsIndex: 1},
+		"DPanic": {msgIndex: 0, argsIndex: 1},
+		"Panic":  {msgIndex: 0, argsIndex: 1},
+		"Fatal":  {msgIndex: 0, argsIndex: 1},
+		"With":   {msgIndex: -1, argsIndex: 0},
+	},
+	loggerZapSugar: {
+		"Debugw":  {msgIndex: 0, argsIndex: 1, keyValues: true},
+		"Infow":   {msgIndex: 0, argsIndex: 1, keyValues: true},
+		"Warnw":   {msgIndex: 0, argsIndex: 1, keyValues: true},
+		"Errorw":  {msgIndex: 0, argsIndex: 1, keyValues: true},
+		"DPanicw": {msgIndex: 0, argsIndex: 1, keyValues: true},
+		"Panicw":  {msgIndex: 0, argsIndex: 1, keyValues: true},
+		"Fatalw":  {msgIndex: 0, argsIndex: 1, keyValues: true},
+		"With":    {msgIndex: -1, argsIndex: 0, keyValues: true},
+	},
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	var calls []LogCall
+	nodeFilter := []ast.Node{(*ast.CallExpr)(nil)}
+	insp.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		if call, ok := find(pass, n.(*ast.CallExpr)); ok {
+			// The inspector reuses the stack slice.
+			call.Stack = slices.Clone(stack)
+			calls = append(calls, call)
+		}
+		return true
+	})
+	return calls, nil
+}
+
+// find describes node if it is a log call.
+func find(pass *analysis.Pass, node *ast.CallExpr) (LogCall, bool) {
+	sel, ok := node.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return LogCall{}, false
+	}
+
+	kind := loggerKindOf(pass, sel.X)
+	layout, ok := logMethods[kind][sel.Sel.Name]
+	if !ok || layout.msgIndex >= len(node.Args) {
+		return LogCall{}, false
+	}
+
+	call := LogCall{
+		Pos:          node.Pos(),
+		Call:         node,
+		Func:         calleeFunc(pass, node),
+		Backend:      loggerBackends[kind],
+		Method:       sel.Sel.Name,
+		Level:        callLevel(pass, node, layout),
+		Logger:       sel.X,
+		PackageLevel: kind == loggerSlog || kind == loggerLog,
+		Format:       layout.format,
+		ChainArgs:    chainArgs(pass, sel.X),
+		KeyValues:    layout.keyValues,
+	}
+	if layout.msgIndex >= 0 {
+		call.MsgExpr = node.Args[layout.msgIndex]
+		if tv, ok := pass.TypesInfo.Types[call.MsgExpr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+			call.Constant = true
+			call.ConstMsg = constant.StringVal(tv.Value)
+		}
+	}
+	if layout.argsIndex >= 0 && layout.argsIndex < len(node.Args) && !node.Ellipsis.IsValid() {
+		call.Args = node.Args[layout.argsIndex:]
+	}
+	return call, true
+}
+
+func loggerKindOf(pass *analysis.Pass, expr ast.Expr) loggerKind {
+	if ident, ok := expr.(*ast.Ident); ok {
+		if pkgName, ok := pass.TypesInfo.ObjectOf(ident).(*types.PkgName); ok {
+			switch pkgName.Imported().Path() {
+			case slogPackage:
+				return loggerSlog
+			case logPackage:
+				return loggerLog
+			}
+			return loggerNone
+		}
+	}
+
+	ptr, ok := pass.TypesInfo.TypeOf(expr).(*types.Pointer)
+	if !ok {
+		return loggerNone
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return loggerNone
+	}
+
+	switch pkg, name := named.Obj().Pkg().Path(), named.Obj().Name(); {
+	case pkg == slogPackage && name == "Logger":
+		return loggerSlogLogger
+	case pkg == zapPackage && name == "Logger":
+		return loggerZap
+	case pkg == zapPackage && name == "SugaredLogger":
+		return loggerZapSugar
+	case pkg == logPackage && name == "Logger":
+		return loggerLogLogger
+	}
+	return loggerNone
+}
+
+// chainArgs collects attribute arguments of the With calls the logger
+// expression is derived from, e.g. logger.With("a", 1).Info(...).
+func chainArgs(pass *analysis.Pass, expr ast.Expr) []ast.Expr {
+	var args []ast.Expr
+	for {
+		call, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok || call.Ellipsis.IsValid() {
+			return args
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "With" || loggerKindOf(pass, sel.X) == loggerNone {
+			return args
+		}
+		args = append(args, call.Args...)
+		expr = sel.X
+	}
+}
+
+// calleeFunc returns the called function or method.
+func calleeFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	return fn
+}
+
+// callLevel returns the normalized level of a log call. For slog.Log and
+// slog.LogAttrs the level argument has to be a constant.
+func callLevel(pass *analysis.Pass, call *ast.CallExpr, layout callLayout) Level {
+	method := call.Fun.(*ast.SelectorExpr).Sel.Name
+	if method != "Log" && method != "LogAttrs" {
+		return MethodLevel(method)
+	}
+
+	if layout.msgIndex < 1 || len(call.Args) < layout.msgIndex {
+		return LevelUnknown
+	}
+	tv, ok := pass.TypesInfo.Types[call.Args[layout.msgIndex-1]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return LevelUnknown
+	}
+	value, ok := constant.Int64Val(tv.Value)
+	if !ok {
+		return LevelUnknown
+	}
+	return SlogLevel(value)
+}
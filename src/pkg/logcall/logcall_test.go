@@ -0,0 +1,68 @@
+package logcall_test
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/demidshumakher/loglinter/pkg/logcall"
+)
+
+func TestAnalyzer(t *testing.T) {
+	results := analysistest.Run(t, analysistest.TestData(), logcall.Analyzer, "logcall")
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	calls := results[0].Result.([]logcall.LogCall)
+
+	type call struct {
+		backend  logcall.Backend
+		method   string
+		level    logcall.Level
+		logger   string
+		msg      string
+		constant bool
+		format   bool
+		args     int
+		chain    int
+	}
+	want := []call{
+		{logcall.BackendSlog, "Info", logcall.LevelInfo, "slog", "server started", true, false, 2, 0},
+		{logcall.BackendSlog, "Log", logcall.LevelWarn, "sl", "slow start", true, false, 0, 0},
+		{logcall.BackendZap, "Error", logcall.LevelError, `logger.With(zap.Int("port", port))`, "listen failed", true, false, 1, 1},
+		{logcall.BackendZap, "With", logcall.LevelUnknown, "logger", "", false, false, 1, 0},
+		{logcall.BackendLog, "Printf", logcall.LevelInfo, "log", "listening on %d", true, true, 0, 0},
+		{logcall.BackendLog, "Printf", logcall.LevelInfo, "log", "", false, true, 0, 0},
+	}
+
+	if len(calls) != len(want) {
+		t.Fatalf("got %d log calls, want %d", len(calls), len(want))
+	}
+	for i, c := range calls {
+		got := call{
+			backend:  c.Backend,
+			method:   c.Method,
+			level:    c.Level,
+			logger:   types.ExprString(c.Logger),
+			msg:      c.ConstMsg,
+			constant: c.Constant,
+			format:   c.Format,
+			args:     len(c.Args),
+			chain:    len(c.ChainArgs),
+		}
+		if got != want[i] {
+			t.Errorf("log call %d = %+v, want %+v", i, got, want[i])
+		}
+		if c.Pos != c.Call.Pos() || c.Stack[len(c.Stack)-1] != c.Call {
+			t.Errorf("log call %d has position or stack not matching the call", i)
+		}
+		if c.Func == nil || c.Func.Name() != c.Method {
+			t.Errorf("log call %d resolves to %v, want the method %s", i, c.Func, c.Method)
+		}
+		if _, ok := c.Stack[0].(*ast.File); !ok {
+			t.Errorf("log call %d stack starts with %T, want *ast.File", i, c.Stack[0])
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Package report converts analyzer diagnostics into findings and writes them
+// as plain text, JSON or SARIF.
+package report
+
+import (
+	"cmp"
+	"fmt"
+	"go/token"
+	"io"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityNote    = "note"
+)
+
+// Finding is a diagnostic reported by a rule.
+type Finding struct {
+	RuleID   string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Package  string `json:"package"`
+	// Function is the function declaration containing the finding, empty
+	// for findings outside functions.
+	Function string   `json:"function,omitempty"`
+	Pos      Position `json:"pos"`
+	End      Position `json:"end"`
+	Fixes    []Fix    `json:"fixes,omitempty"`
+}
+
+// Position is a file position with 1-based line and column.
+type Position struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// Fix is a suggested fix of a finding.
+type Fix struct {
+	Message string `json:"message"`
+	Edits   []Edit `json:"edits"`
+}
+
+// Edit replaces the text between Start and End with NewText.
+type Edit struct {
+	Start   Position `json:"start"`
+	End     Position `json:"end"`
+	NewText string   `json:"new_text"`
+}
+
+// RuleInfo describes a rule in reports.
+type RuleInfo struct {
+	ID          string
+	Description string
+	Severity    string
+}
+
+// NewFinding converts a diagnostic of package pkgPath into a finding.
+func NewFinding(fset *token.FileSet, pkgPath string, diag analysis.Diagnostic, severity string) Finding {
+	end := diag.End
+	if !end.IsValid() {
+		end = diag.Pos
+	}
+
+	finding := Finding{
+		RuleID:   diag.Category,
+		Severity: severity,
+		Message:  diag.Message,
+		Package:  pkgPath,
+		Pos:      position(fset, diag.Pos),
+		End:      position(fset, end),
+	}
+	for _, fix := range diag.SuggestedFixes {
+		f := Fix{Message: fix.Message}
+		for _, edit := range fix.TextEdits {
+			editEnd := edit.End
+			if !editEnd.IsValid() {
+				editEnd = edit.Pos
+			}
+			f.Edits = append(f.Edits, Edit{
+				Start:   position(fset, edit.Pos),
+				End:     position(fset, editEnd),
+				NewText: string(edit.NewText),
+			})
+		}
+		finding.Fixes = append(finding.Fixes, f)
+	}
+	return finding
+}
+
+func position(fset *token.FileSet, pos token.Pos) Position {
+	p := fset.Position(pos)
+	return Position{File: p.Filename, Line: p.Line, Column: p.Column}
+}
+
+// Sort orders findings by position and rule, and drops duplicates reported
+// for the same package built with and without its tests.
+func Sort(findings []Finding) []Finding {
+	slices.SortFunc(findings, compare)
+	return slices.CompactFunc(findings, func(a, b Finding) bool {
+		return compare(a, b) == 0
+	})
+}
+
+func compare(a, b Finding) int {
+	return cmp.Or(
+		cmp.Compare(a.Pos.File, b.Pos.File),
+		cmp.Compare(a.Pos.Line, b.Pos.Line),
+		cmp.Compare(a.Pos.Column, b.Pos.Column),
+		cmp.Compare(a.RuleID, b.RuleID),
+		cmp.Compare(a.Message, b.Message),
+	)
+}
+
+// WriteText writes one line per finding in the format of go vet.
+func WriteText(w io.Writer, findings []Finding) error {
+	for _, f := range findings {
+		if _, err := fmt.Fprintf(w, "%s: %s (%s)\n", f.Pos, f.Message, f.RuleID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
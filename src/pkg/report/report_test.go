@@ -0,0 +1,94 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var testFindings = []Finding{
+	{
+		RuleID:   "lowercase",
+		Severity: SeverityError,
+		Message:  "log message should start with a lowercase letter",
+		Package:  "example.com/app",
+		Pos:      Position{File: "main.go", Line: 10, Column: 12},
+		End:      Position{File: "main.go", Line: 10, Column: 29},
+		Fixes: []Fix{{
+			Message: "Change to lowercase",
+			Edits: []Edit{{
+				Start:   Position{File: "main.go", Line: 10, Column: 12},
+				End:     Position{File: "main.go", Line: 10, Column: 29},
+				NewText: `"starting server"`,
+			}},
+		}},
+	},
+	{
+		RuleID:   "loop_logging",
+		Severity: SeverityWarning,
+		Message:  "info log call inside a loop, sample it or move it out of the loop",
+		Package:  "example.com/app",
+		Pos:      Position{File: "worker.go", Line: 3, Column: 3},
+		End:      Position{File: "worker.go", Line: 3, Column: 40},
+	},
+}
+
+func TestSort(t *testing.T) {
+	findings := Sort([]Finding{testFindings[1], testFindings[0], testFindings[1]})
+	if len(findings) != 2 || findings[0].RuleID != "lowercase" || findings[1].RuleID != "loop_logging" {
+		t.Errorf("Sort returned %+v", findings)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteText(&buf, testFindings); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `main.go:10:12: log message should start with a lowercase letter (lowercase)
+worker.go:3:3: info log call inside a loop, sample it or move it out of the loop (loop_logging)
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteText:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	rules := []RuleInfo{{ID: "lowercase", Description: "Checks that log messages start with a lowercase letter", Severity: SeverityError}}
+	if err := WriteSARIF(&buf, testFindings, rules); err != nil {
+		t.Fatal(err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected SARIF log %+v", log)
+	}
+
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[1].ID != "loop_logging" {
+		t.Errorf("rules = %+v, want lowercase and loop_logging", run.Tool.Driver.Rules)
+	}
+	if len(run.Results) != 2 {
+		t.Fatalf("got %d results, want 2", len(run.Results))
+	}
+
+	result := run.Results[0]
+	if result.RuleID != "lowercase" || result.RuleIndex != 0 || result.Level != "error" {
+		t.Errorf("result = %+v", result)
+	}
+	region := result.Locations[0].PhysicalLocation.Region
+	if region != (sarifRegion{StartLine: 10, StartColumn: 12, EndLine: 10, EndColumn: 29}) {
+		t.Errorf("region = %+v", region)
+	}
+	if len(result.Fixes) != 1 || result.Fixes[0].ArtifactChanges[0].Replacements[0].InsertedContent.Text != `"starting server"` {
+		t.Errorf("fixes = %+v", result.Fixes)
+	}
+	if run.Results[1].RuleIndex != 1 || run.Results[1].Fixes != nil {
+		t.Errorf("result = %+v", run.Results[1])
+	}
+}
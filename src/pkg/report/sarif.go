@@ -0,0 +1,189 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"slices"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolName     = "loglinter"
+	toolURI      = "https://github.com/demidshumakher/loglinter"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion  `json:"deletedRegion"`
+	InsertedContent sarifMessage `json:"insertedContent"`
+}
+
+// WriteSARIF writes the findings as a SARIF 2.1.0 log with a single run.
+// Rules lists the rules of the run; findings of rules missing from it are
+// added with an empty description.
+func WriteSARIF(w io.Writer, findings []Finding, rules []RuleInfo) error {
+	driver := sarifDriver{Name: toolName, InformationURI: toolURI, Rules: []sarifRule{}}
+	index := make(map[string]int)
+	addRule := func(rule RuleInfo) {
+		index[rule.ID] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevel(rule.Severity)},
+		})
+	}
+	for _, rule := range rules {
+		addRule(rule)
+	}
+
+	results := make([]sarifResult, 0, len(findings))
+	for _, f := range findings {
+		if _, ok := index[f.RuleID]; !ok {
+			addRule(RuleInfo{ID: f.RuleID, Severity: f.Severity})
+		}
+
+		result := sarifResult{
+			RuleID:    f.RuleID,
+			RuleIndex: index[f.RuleID],
+			Level:     sarifLevel(f.Severity),
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: sarifURI(f.Pos.File)},
+					Region:           sarifRegionOf(f.Pos, f.End),
+				},
+			}},
+		}
+		for _, fix := range f.Fixes {
+			result.Fixes = append(result.Fixes, sarifFixOf(fix))
+		}
+		results = append(results, result)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	})
+}
+
+// sarifFixOf groups the edits of a fix by file, keeping the order of files
+// as they first appear.
+func sarifFixOf(fix Fix) sarifFix {
+	result := sarifFix{Description: sarifMessage{Text: fix.Message}}
+	for _, edit := range fix.Edits {
+		uri := sarifURI(edit.Start.File)
+		i := slices.IndexFunc(result.ArtifactChanges, func(change sarifArtifactChange) bool {
+			return change.ArtifactLocation.URI == uri
+		})
+		if i < 0 {
+			i = len(result.ArtifactChanges)
+			result.ArtifactChanges = append(result.ArtifactChanges, sarifArtifactChange{
+				ArtifactLocation: sarifArtifactLocation{URI: uri},
+			})
+		}
+		result.ArtifactChanges[i].Replacements = append(result.ArtifactChanges[i].Replacements, sarifReplacement{
+			DeletedRegion:   sarifRegionOf(edit.Start, edit.End),
+			InsertedContent: sarifMessage{Text: edit.NewText},
+		})
+	}
+	return result
+}
+
+func sarifRegionOf(start, end Position) sarifRegion {
+	return sarifRegion{
+		StartLine:   start.Line,
+		StartColumn: start.Column,
+		EndLine:     end.Line,
+		EndColumn:   end.Column,
+	}
+}
+
+func sarifLevel(severity string) string {
+	switch severity {
+	case SeverityError, SeverityNote:
+		return severity
+	}
+	return SeverityWarning
+}
+
+func sarifURI(file string) string {
+	return filepath.ToSlash(file)
+}
@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const RuleAttrKeysName = "attr_keys"
+
+const (
+	KeyStyleSnake  = "snake"
+	KeyStyleCamel  = "camel"
+	KeyStyleKebab  = "kebab"
+	KeyStyleDotted = "dotted"
+)
+
+var keyStylePatterns = map[string]*regexp.Regexp{
+	KeyStyleSnake:  regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+	KeyStyleCamel:  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	KeyStyleKebab:  regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	KeyStyleDotted: regexp.MustCompile(`^[a-z0-9_]+(\.[a-z0-9_]+)*$`),
+}
+
+var keyStyleNames = map[string]string{
+	KeyStyleSnake:  "snake_case",
+	KeyStyleCamel:  "camelCase",
+	KeyStyleKebab:  "kebab-case",
+	KeyStyleDotted: "dotted.case",
+}
+
+var DefaultReservedKeys = []string{
+	"msg",
+	"level",
+	"time",
+	"source",
+}
+
+type AttrKeysRule struct {
+	BaseRule
+	style    string
+	reserved []string
+}
+
+func NewAttrKeysRule() Rule {
+	r := &AttrKeysRule{
+		BaseRule: NewBaseRule(RuleAttrKeysName, "Checks that attribute keys follow the naming style and are not reserved"),
+		style:    KeyStyleSnake,
+		reserved: DefaultReservedKeys,
+	}
+	r.SetEnabled(false)
+	return r
+}
+
+func (r *AttrKeysRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL007",
+		Options: []RuleOption{
+			{Name: "style", Type: "string", Default: KeyStyleSnake, Description: "key style: snake, camel, kebab or dotted"},
+			{Name: "reserved", Type: "[]string", Default: strings.Join(DefaultReservedKeys, ", "), Description: "keys used by the log handler itself"},
+		},
+		Examples: []string{`slog.Info("user created", "userID", id)`, `slog.Info("user created", "time", now)`},
+	}
+}
+
+func (r *AttrKeysRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if style, ok := config["style"].(string); ok {
+		if _, known := keyStylePatterns[style]; !known {
+			return fmt.Errorf("attr_keys: unknown style %q", style)
+		}
+		r.style = style
+	}
+
+	if reserved, ok := config["reserved"].([]any); ok {
+		r.reserved = toStrings(reserved)
+	}
+
+	return nil
+}
+
+func (r *AttrKeysRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.TypesInfo == nil {
+		return ResultPass()
+	}
+
+	attrs, _ := ParseAttrs(ctx.TypesInfo, ctx.Args, ctx.KeyValues)
+	for _, attr := range attrs {
+		if attr.Key == "" {
+			continue
+		}
+
+		if slices.Contains(r.reserved, attr.Key) {
+			return ResultFailAt(attr.KeyExpr, fmt.Sprintf("log key %q is reserved", attr.Key))
+		}
+
+		valid, suggestion := CheckKeyStyle(attr.Key, r.style)
+		if valid {
+			continue
+		}
+
+		message := fmt.Sprintf("log key %q should be %s", attr.Key, keyStyleNames[r.style])
+		if !isBasicLit(attr.KeyExpr) || suggestion == "" {
+			return ResultFailAt(attr.KeyExpr, message)
+		}
+		result := ResultFailWithSuggestion(message, fmt.Sprintf("Rename to %s", suggestion), suggestion)
+		result.Expr = attr.KeyExpr
+		return result
+	}
+
+	return ResultPass()
+}
+
+// CheckKeyStyle reports whether key follows style and, if not, returns the
+// key converted to it.
+func CheckKeyStyle(key, style string) (bool, string) {
+	if keyStylePatterns[style].MatchString(key) {
+		return true, ""
+	}
+
+	words := splitKeyWords(key)
+	if len(words) == 0 {
+		return false, ""
+	}
+
+	var converted string
+	switch style {
+	case KeyStyleSnake:
+		converted = strings.Join(words, "_")
+	case KeyStyleKebab:
+		converted = strings.Join(words, "-")
+	case KeyStyleDotted:
+		converted = strings.Join(words, ".")
+	case KeyStyleCamel:
+		for i, w := range words {
+			if i > 0 {
+				runes := []rune(w)
+				runes[0] = unicode.ToUpper(runes[0])
+				w = string(runes)
+			}
+			converted += w
+		}
+	}
+
+	if !keyStylePatterns[style].MatchString(converted) {
+		return false, ""
+	}
+	return false, converted
+}
+
+// splitKeyWords splits a key into lowercase words on separators and on
+// camel case boundaries, keeping acronyms together: "HTTPStatus-code" gives
+// "http", "status" and "code".
+func splitKeyWords(key string) []string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+
+	runes := []rune(key)
+	for i, ch := range runes {
+		switch {
+		case ch == '_' || ch == '-' || ch == '.' || unicode.IsSpace(ch):
+			flush()
+		case unicode.IsUpper(ch):
+			prev := runes[max(i-1, 0)]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if i > 0 && (unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower)) {
+				flush()
+			}
+			word = append(word, unicode.ToLower(ch))
+		default:
+			word = append(word, ch)
+		}
+	}
+	flush()
+
+	return words
+}
@@ -0,0 +1,173 @@
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+)
+
+const (
+	slogPackage    = "log/slog"
+	zapPackage     = "go.uber.org/zap"
+	zapcorePackage = "go.uber.org/zap/zapcore"
+	logPackage     = "log"
+)
+
+// Attr is a structured attribute passed to a log call.
+type Attr struct {
+	// Arg is the argument the attribute starts at: the key of a key-value
+	// pair or the slog.Attr or zap.Field value.
+	Arg ast.Expr
+	// KeyExpr is the key expression, nil if the key is not known statically.
+	KeyExpr ast.Expr
+	// Key is the constant value of KeyExpr, empty if it is not a constant.
+	Key string
+	// Value is the value expression, nil for attributes built elsewhere.
+	Value ast.Expr
+}
+
+// ParseAttrs splits attribute arguments of a log call into attributes. With
+// keyValues set the arguments are paired the way slog pairs them, otherwise
+// every argument is expected to be a slog.Attr or zap.Field. Parsing stops at
+// the first malformed argument, which is returned as bad.
+func ParseAttrs(info *types.Info, args []ast.Expr, keyValues bool) (attrs []Attr, bad ast.Expr) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		typ := info.TypeOf(arg)
+		if typ == nil {
+			return attrs, nil
+		}
+
+		switch {
+		case isAttrType(typ):
+			attr := constructorAttr(info, arg)
+			attr.Arg = arg
+			attrs = append(attrs, attr)
+		case !keyValues:
+			return attrs, arg
+		case isStringType(typ):
+			if i == len(args)-1 {
+				return attrs, arg
+			}
+			attr := Attr{Arg: arg, KeyExpr: arg, Value: args[i+1]}
+			attr.Key, _ = constString(info, arg)
+			attrs = append(attrs, attr)
+			i++
+		case types.IsInterface(typ):
+			// The dynamic type of the key is unknown, assume a string.
+			if i == len(args)-1 {
+				return attrs, nil
+			}
+			attrs = append(attrs, Attr{Arg: arg, Value: args[i+1]})
+			i++
+		default:
+			return attrs, arg
+		}
+	}
+	return attrs, nil
+}
+
+// constructorAttr describes an attribute built by a constructor call such as
+// slog.String("key", v) or zap.Int("key", v).
+func constructorAttr(info *types.Info, expr ast.Expr) Attr {
+	if call, ok := ast.Unparen(expr).(*ast.CallExpr); ok && len(call.Args) == 1 {
+		if fn := calledFunc(info, call); fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == zapPackage && fn.Name() == "Error" {
+			// zap.Error(err) is a shorthand for zap.NamedError("error", err).
+			return Attr{Key: "error", Value: call.Args[0]}
+		}
+	}
+
+	keyExpr := attrKeyExpr(info, expr)
+	if keyExpr == nil {
+		return Attr{}
+	}
+
+	attr := Attr{KeyExpr: keyExpr}
+	attr.Key, _ = constString(info, keyExpr)
+	if call := ast.Unparen(expr).(*ast.CallExpr); len(call.Args) > 1 {
+		attr.Value = call.Args[1]
+	}
+	return attr
+}
+
+// isAttrType reports whether t is a structured attribute that occupies a
+// single argument slot: slog.Attr or zap.Field.
+func isAttrType(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	switch pkg, name := named.Obj().Pkg().Path(), named.Obj().Name(); {
+	case pkg == slogPackage && name == "Attr":
+		return true
+	case pkg == zapcorePackage && name == "Field":
+		return true
+	}
+	return false
+}
+
+func isBasicLit(expr ast.Expr) bool {
+	_, ok := expr.(*ast.BasicLit)
+	return ok
+}
+
+func isStringType(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+// constString returns the value of a constant string expression.
+func constString(info *types.Info, expr ast.Expr) (string, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// attrKeyExpr returns the key argument of an attribute constructor call
+// such as slog.String("key", v) or zap.Int("key", v).
+func attrKeyExpr(info *types.Info, expr ast.Expr) ast.Expr {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return nil
+	}
+
+	fn := calledFunc(info, call)
+	if fn == nil || fn.Pkg() == nil {
+		return nil
+	}
+	if path := fn.Pkg().Path(); path != slogPackage && path != zapPackage {
+		return nil
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() != nil || sig.Results().Len() != 1 || !isAttrType(sig.Results().At(0).Type()) {
+		return nil
+	}
+	if sig.Params().Len() == 0 || !isStringType(sig.Params().At(0).Type()) {
+		return nil
+	}
+	return call.Args[0]
+}
+
+// selectorOf returns the selector of a method or qualified function call.
+func selectorOf(call *ast.CallExpr) *ast.SelectorExpr {
+	sel, _ := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	return sel
+}
+
+func calledFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return nil
+	}
+	fn, _ := info.Uses[ident].(*types.Func)
+	return fn
+}
@@ -0,0 +1,214 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+	"gopkg.in/yaml.v3"
+)
+
+const RuleCatalogName = "catalog"
+
+// MessageCatalog is a list of approved log messages.
+type MessageCatalog struct {
+	Messages []CatalogMessage `yaml:"messages" json:"messages"`
+}
+
+// CatalogMessage is an approved log message with its event ID.
+type CatalogMessage struct {
+	ID      string `yaml:"id" json:"id"`
+	Message string `yaml:"message" json:"message"`
+}
+
+// CatalogFact records the catalog messages logged by a package and the
+// packages it imports.
+type CatalogFact struct {
+	Messages []string
+}
+
+func (*CatalogFact) AFact() {}
+
+func (f *CatalogFact) String() string {
+	return fmt.Sprintf("catalog(%d)", len(f.Messages))
+}
+
+type CatalogRule struct {
+	BaseRule
+	path           string
+	ids            map[string]string
+	messages       []CatalogMessage
+	requireEventID bool
+	eventIDKey     string
+	reportUnused   bool
+
+	used map[string]bool
+}
+
+func NewCatalogRule() Rule {
+	r := &CatalogRule{
+		BaseRule:   NewBaseRule(RuleCatalogName, "Checks that log messages come from the approved message catalog"),
+		eventIDKey: "event_id",
+		used:       make(map[string]bool),
+	}
+	r.SetEnabled(false)
+	return r
+}
+
+func (r *CatalogRule) ForPackage() Rule {
+	c := *r
+	c.used = make(map[string]bool)
+	return &c
+}
+
+func (r *CatalogRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL019",
+		Options: []RuleOption{
+			{Name: "path", Type: "string", Description: "YAML or JSON catalog of approved messages"},
+			{Name: "require_event_id", Type: "bool", Default: "false", Description: "require the event ID attribute of the catalog entry"},
+			{Name: "event_id_key", Type: "string", Default: "event_id", Description: "key of the event ID attribute"},
+			{Name: "report_unused", Type: "bool", Default: "false", Description: "report catalog entries never logged, in package main; enable it when only one main package is checked"},
+		},
+		Examples: []string{`slog.Info("cache warmed up") // not in the catalog`},
+	}
+}
+
+func (r *CatalogRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if path, ok := config["path"].(string); ok && path != "" {
+		catalog, err := LoadMessageCatalog(path)
+		if err != nil {
+			return fmt.Errorf("catalog: %w", err)
+		}
+		r.path = path
+		r.messages = catalog.Messages
+		r.ids = make(map[string]string, len(catalog.Messages))
+		for _, entry := range catalog.Messages {
+			r.ids[entry.Message] = entry.ID
+		}
+	}
+	if require, ok := config["require_event_id"].(bool); ok {
+		r.requireEventID = require
+	}
+	if key, ok := config["event_id_key"].(string); ok && key != "" {
+		r.eventIDKey = key
+	}
+	if report, ok := config["report_unused"].(bool); ok {
+		r.reportUnused = report
+	}
+
+	return nil
+}
+
+// LoadMessageCatalog reads a message catalog from a YAML or JSON file.
+func LoadMessageCatalog(path string) (*MessageCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog: %w", err)
+	}
+
+	var catalog MessageCatalog
+	if err := yaml.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse catalog %s: %w", path, err)
+	}
+	return &catalog, nil
+}
+
+func (r *CatalogRule) FactTypes() []analysis.Fact {
+	return []analysis.Fact{new(CatalogFact)}
+}
+
+func (r *CatalogRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || r.ids == nil || ctx.MsgExpr == nil {
+		return ResultPass()
+	}
+
+	msg := ctx.ConstMsg
+	if !ctx.Constant {
+		return ResultPass()
+	}
+	id, ok := r.ids[msg]
+	if !ok {
+		return ResultFailAt(ctx.MsgExpr, fmt.Sprintf("log message %q is not in the message catalog %s", msg, r.path))
+	}
+	r.used[msg] = true
+
+	if !r.requireEventID {
+		return ResultPass()
+	}
+
+	attr, found := r.eventIDAttr(ctx)
+	if !found {
+		return ResultFailAt(ctx.Call, fmt.Sprintf("log message %q should have the attribute %s=%q", msg, r.eventIDKey, id))
+	}
+	if value, ok := constString(ctx.TypesInfo, attr.Value); !ok || value != id {
+		return ResultFailAt(attr.Value, fmt.Sprintf("%s of log message %q should be %q", r.eventIDKey, msg, id))
+	}
+	return ResultPass()
+}
+
+func (r *CatalogRule) eventIDAttr(ctx *CheckContext) (Attr, bool) {
+	for _, args := range [][]Attr{parsedAttrs(ctx, ctx.Args), parsedAttrs(ctx, ctx.ChainArgs)} {
+		for _, attr := range args {
+			if attr.Key == r.eventIDKey && attr.Value != nil {
+				return attr, true
+			}
+		}
+	}
+	return Attr{}, false
+}
+
+func parsedAttrs(ctx *CheckContext, args []ast.Expr) []Attr {
+	attrs, _ := ParseAttrs(ctx.TypesInfo, args, ctx.KeyValues)
+	return attrs
+}
+
+// Finish exports the catalog messages logged by the package and its imports.
+// With report_unused, in package main, where the whole program is known, it
+// also reports catalog entries that are never logged. Every main package of
+// the run reports them, so the option is off by default and meant for a run
+// that checks a single program.
+func (r *CatalogRule) Finish(pass *analysis.Pass) {
+	if !r.Enabled() || r.ids == nil {
+		return
+	}
+
+	used := make(map[string]bool, len(r.used))
+	for msg := range r.used {
+		used[msg] = true
+	}
+	for _, pf := range pass.AllPackageFacts() {
+		if fact, ok := pf.Fact.(*CatalogFact); ok {
+			for _, msg := range fact.Messages {
+				used[msg] = true
+			}
+		}
+	}
+
+	if r.reportUnused && pass.Pkg.Name() == "main" && len(pass.Files) > 0 {
+		for _, entry := range r.messages {
+			if !used[entry.Message] {
+				pass.Report(analysis.Diagnostic{
+					Pos:      pass.Files[0].Name.Pos(),
+					Category: r.Name(),
+					Message:  fmt.Sprintf("message catalog entry %s %q is never logged", entry.ID, entry.Message),
+				})
+			}
+		}
+	}
+
+	if len(used) > 0 {
+		fact := &CatalogFact{Messages: make([]string, 0, len(used))}
+		for msg := range used {
+			fact.Messages = append(fact.Messages, msg)
+		}
+		sort.Strings(fact.Messages)
+		pass.ExportPackageFact(fact)
+	}
+}
@@ -22,6 +22,16 @@ func NewCustomPatternsRule() Rule {
 	}
 }
 
+func (r *CustomPatternsRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL005",
+		Options: []RuleOption{
+			{Name: "patterns", Type: "[]string", Description: "regular expressions log messages must not match"},
+		},
+		Examples: []string{`slog.Info("TODO: remove")`},
+	}
+}
+
 func (r *CustomPatternsRule) Configure(config map[string]any) error {
 	if err := r.BaseRule.Configure(config); err != nil {
 		return err
@@ -49,7 +59,7 @@ func (r *CustomPatternsRule) Configure(config map[string]any) error {
 }
 
 func (r *CustomPatternsRule) Check(ctx *CheckContext) *RuleResult {
-	if !r.Enabled() {
+	if !r.Enabled() || ctx.MsgExpr == nil {
 		return ResultPass()
 	}
 
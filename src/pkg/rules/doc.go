@@ -0,0 +1,34 @@
+package rules
+
+// DocumentedRule is implemented by rules that document their code, options
+// and examples for rule listings.
+type DocumentedRule interface {
+	Rule
+	Doc() RuleDoc
+}
+
+// RuleDoc documents a rule.
+type RuleDoc struct {
+	// Code is a short stable identifier of the rule, such as LL001.
+	Code    string       `json:"code"`
+	Options []RuleOption `json:"options,omitempty"`
+	// Examples are log calls the rule reports.
+	Examples []string `json:"examples,omitempty"`
+}
+
+// RuleOption is a configuration key of a rule.
+type RuleOption struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description"`
+}
+
+// RuleDescription describes a registered rule.
+type RuleDescription struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Enabled reports whether the rule is enabled by default.
+	Enabled bool `json:"enabled"`
+	RuleDoc
+}
@@ -14,6 +14,13 @@ func NewEnglishOnlyRule() Rule {
 	}
 }
 
+func (r *EnglishOnlyRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL003",
+		Examples: []string{`slog.Info("запуск сервера")`},
+	}
+}
+
 func (r *EnglishOnlyRule) Check(ctx *CheckContext) *RuleResult {
 	if !r.Enabled() {
 		return ResultPass()
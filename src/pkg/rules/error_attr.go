@@ -0,0 +1,145 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const RuleErrorAttrName = "error_attr"
+
+var errorMethods = map[Backend][]string{
+	BackendSlog:     {"Error", "ErrorContext"},
+	BackendZap:      {"Error", "DPanic", "Fatal"},
+	BackendZapSugar: {"Errorw", "DPanicw", "Fatalw"},
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+type ErrorAttrRule struct {
+	BaseRule
+}
+
+func NewErrorAttrRule() Rule {
+	return &ErrorAttrRule{
+		BaseRule: NewBaseRule(RuleErrorAttrName, "Checks that error-level log calls include an error attribute"),
+	}
+}
+
+func (r *ErrorAttrRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL010",
+		Examples: []string{`logger.Error("failed to connect")`},
+	}
+}
+
+func (r *ErrorAttrRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil || ctx.Call.Ellipsis.IsValid() {
+		return ResultPass()
+	}
+	if !slices.Contains(errorMethods[ctx.Backend], ctx.Method) {
+		return ResultPass()
+	}
+	if stringifiedError(ctx.TypesInfo, ctx.MsgExpr) != nil {
+		// The error is formatted into the message, no_error_string reports it.
+		return ResultPass()
+	}
+
+	for _, args := range [][]ast.Expr{ctx.ChainArgs, ctx.Args} {
+		attrs, bad := ParseAttrs(ctx.TypesInfo, args, ctx.KeyValues)
+		if bad != nil {
+			return ResultPass()
+		}
+		for _, attr := range attrs {
+			if attr.Value == nil && attr.KeyExpr == nil {
+				// An attribute built elsewhere may carry the error.
+				return ResultPass()
+			}
+			if attr.Value != nil && isError(ctx.TypesInfo.TypeOf(attr.Value)) {
+				return ResultPass()
+			}
+			if attr.Value != nil && stringifiedError(ctx.TypesInfo, attr.Value) != nil {
+				// The error is formatted into a string, no_error_string reports it.
+				return ResultPass()
+			}
+		}
+	}
+
+	result := ResultFailAt(ctx.Call, "error-level log call should include an error attribute")
+	if fix := errorAttrFix(ctx); fix != nil {
+		result.SuggestedFix = fix
+	}
+	return result
+}
+
+func isError(t types.Type) bool {
+	return t != nil && types.Implements(t, errorType)
+}
+
+// errorAttrFix appends the err variable in scope as an error attribute.
+func errorAttrFix(ctx *CheckContext) *SuggestedFix {
+	call := ctx.Call
+	scope := ctx.Pass.Pkg.Scope().Innermost(call.Pos())
+	if scope == nil {
+		return nil
+	}
+	_, obj := scope.LookupParent("err", call.Pos())
+	if v, ok := obj.(*types.Var); !ok || !isError(v.Type()) {
+		return nil
+	}
+
+	attr := errorAttrText(ctx, "err")
+	if attr == "" {
+		return nil
+	}
+
+	pos := call.Args[len(call.Args)-1].End()
+	return &SuggestedFix{
+		Message: "Add the err variable",
+		Edits:   []TextEdit{{Pos: pos, End: pos, NewText: ", " + attr}},
+	}
+}
+
+// errorAttrText returns the source of an error attribute holding errText in
+// the style of the call's backend, or an empty string if the file does not
+// import the package needed for it.
+func errorAttrText(ctx *CheckContext, errText string) string {
+	switch {
+	case ctx.KeyValues:
+		return `"error", ` + errText
+	case ctx.Backend == BackendZap:
+		if name := importName(ctx.Pass, ctx.Call, zapPackage); name != "" {
+			return name + ".Error(" + errText + ")"
+		}
+	default:
+		if name := importName(ctx.Pass, ctx.Call, slogPackage); name != "" {
+			return name + `.Any("error", ` + errText + ")"
+		}
+	}
+	return ""
+}
+
+// importName returns the name under which the file containing node imports
+// path, or an empty string if it is not imported.
+func importName(pass *analysis.Pass, node ast.Node, path string) string {
+	for _, file := range pass.Files {
+		if file.FileStart > node.Pos() || node.Pos() >= file.FileEnd {
+			continue
+		}
+		for _, spec := range file.Imports {
+			if p, _ := strconv.Unquote(spec.Path.Value); p != path {
+				continue
+			}
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+			if pkg := pass.TypesInfo.PkgNameOf(spec); pkg != nil {
+				return pkg.Imported().Name()
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,126 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+)
+
+const RuleFatalInMainName = "fatal_in_main"
+
+var fatalMethods = map[Backend][]string{
+	BackendZap:      {"Fatal", "Panic"},
+	BackendZapSugar: {"Fatalw", "Panicw"},
+	BackendLog:      {"Fatal", "Fatalf", "Fatalln", "Panic", "Panicf", "Panicln"},
+}
+
+var panicMethods = []string{"Panic", "Panicw", "Panicf", "Panicln"}
+
+type FatalInMainRule struct {
+	BaseRule
+	allow []string
+}
+
+func NewFatalInMainRule() Rule {
+	return &FatalInMainRule{
+		BaseRule: NewBaseRule(RuleFatalInMainName, "Checks that Fatal and Panic log calls are only used in package main"),
+	}
+}
+
+func (r *FatalInMainRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL015",
+		Options: []RuleOption{
+			{Name: "allow", Type: "[]string", Description: "packages besides main allowed to call Fatal and Panic"},
+		},
+		Examples: []string{`logger.Fatal("failed to open store") // outside package main`},
+	}
+}
+
+func (r *FatalInMainRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if allow, ok := config["allow"].([]any); ok {
+		r.allow = toStrings(allow)
+	}
+
+	return nil
+}
+
+func (r *FatalInMainRule) ChecksStdLog() bool { return true }
+
+func (r *FatalInMainRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil || !slices.Contains(fatalMethods[ctx.Backend], ctx.Method) {
+		return ResultPass()
+	}
+
+	name := types.ExprString(selectorOf(ctx.Call).X) + "." + ctx.Method
+	panics := slices.Contains(panicMethods, ctx.Method)
+	effect := "exits the program"
+	if panics {
+		effect = "panics"
+	}
+
+	if stmt := enclosingGoOrDefer(ctx.Stack); stmt != nil {
+		_, deferred := stmt.(*ast.DeferStmt)
+		switch {
+		case !panics && deferred:
+			return ResultFailAt(ctx.Call, fmt.Sprintf("%s inside a deferred function exits the program without running deferred cleanup", name))
+		case !panics:
+			return ResultFailAt(ctx.Call, fmt.Sprintf("%s inside a goroutine exits the program without running deferred cleanup", name))
+		case deferred:
+			return ResultFailAt(ctx.Call, fmt.Sprintf("%s inside a deferred function panics during cleanup and masks any panic in progress", name))
+		default:
+			return ResultFailAt(ctx.Call, fmt.Sprintf("%s inside a goroutine panics and crashes the whole program", name))
+		}
+	}
+
+	if ctx.Pass.Pkg.Name() == "main" || r.allowed(ctx.Pass.Pkg.Path()) {
+		return ResultPass()
+	}
+	return ResultFailAt(ctx.Call, fmt.Sprintf("%s %s, only use it in package main", name, effect))
+}
+
+func (r *FatalInMainRule) allowed(pkgPath string) bool {
+	for _, pattern := range r.allow {
+		if MatchPackage(pattern, pkgPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// enclosingGoOrDefer returns the go or defer statement running the innermost
+// function that contains the last node of stack, if any.
+func enclosingGoOrDefer(stack []ast.Node) ast.Stmt {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.GoStmt:
+			return node
+		case *ast.DeferStmt:
+			return node
+		case *ast.FuncDecl:
+			return nil
+		case *ast.FuncLit:
+			// The literal runs in a go or defer statement only when it is
+			// called right there: go func() { ... }().
+			if i < 2 {
+				return nil
+			}
+			if call, ok := stack[i-1].(*ast.CallExpr); !ok || call.Fun != node {
+				return nil
+			}
+			switch stmt := stack[i-2].(type) {
+			case *ast.GoStmt:
+				return stmt
+			case *ast.DeferStmt:
+				return stmt
+			}
+			return nil
+		}
+	}
+	return nil
+}
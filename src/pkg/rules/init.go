@@ -1,15 +1,31 @@
 package rules
 
-import "sync"
-
-var initOnce sync.Once
-
-func Init() {
-	initOnce.Do(func() {
-		RegisterRule(RuleLowercaseName, NewLowercaseRule)
-		RegisterRule(RuleNoSpecialCharsName, NewNoSpecialCharsRule)
-		RegisterRule(RuleEnglishOnlyName, NewEnglishOnlyRule)
-		RegisterRule(RuleSensitiveWordsName, NewSensitiveWordsRule)
-		RegisterRule(RuleCustomPatternsName, NewCustomPatternsRule)
-	})
+// The built-in rules are registered when the package is initialized, before
+// any package importing it can register its own rules.
+func init() {
+	RegisterRule(RuleLowercaseName, NewLowercaseRule)
+	RegisterRule(RuleNoSpecialCharsName, NewNoSpecialCharsRule)
+	RegisterRule(RuleEnglishOnlyName, NewEnglishOnlyRule)
+	RegisterRule(RuleSensitiveWordsName, NewSensitiveWordsRule)
+	RegisterRule(RuleCustomPatternsName, NewCustomPatternsRule)
+	RegisterRule(RuleKVPairsName, NewKVPairsRule)
+	RegisterRule(RuleAttrKeysName, NewAttrKeysRule)
+	RegisterRule(RuleKeyTypesName, NewKeyTypesRule)
+	RegisterRule(RuleOTelSemconvName, NewOTelSemconvRule)
+	RegisterRule(RuleErrorAttrName, NewErrorAttrRule)
+	RegisterRule(RuleLogAndReturnName, NewLogAndReturnRule)
+	RegisterRule(RuleNoErrorStringName, NewNoErrorStringRule)
+	RegisterRule(RuleUseContextName, NewUseContextRule)
+	RegisterRule(RuleNoGlobalLoggerName, NewNoGlobalLoggerRule)
+	RegisterRule(RuleFatalInMainName, NewFatalInMainRule)
+	RegisterRule(RuleLevelPolicyName, NewLevelPolicyRule)
+	RegisterRule(RuleLoopLoggingName, NewLoopLoggingRule)
+	RegisterRule(RuleUniqueMessagesName, NewUniqueMessagesRule)
+	RegisterRule(RuleCatalogName, NewCatalogRule)
 }
+
+// Init used to register the built-in rules.
+//
+// Deprecated: the built-in rules are registered when the package is
+// initialized, Init does nothing.
+func Init() {}
@@ -0,0 +1,214 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"gopkg.in/yaml.v3"
+)
+
+const RuleKeyTypesName = "key_types"
+
+// KeyTypesFact records the attribute keys logged by a package together with
+// the static types of their values.
+type KeyTypesFact struct {
+	Keys map[string]KeyUse
+}
+
+// KeyUse is the first use of an attribute key in a package.
+type KeyUse struct {
+	Type     string
+	Position string
+}
+
+func (*KeyTypesFact) AFact() {}
+
+func (f *KeyTypesFact) String() string {
+	keys := make([]string, 0, len(f.Keys))
+	for key, use := range f.Keys {
+		keys = append(keys, key+":"+use.Type)
+	}
+	sort.Strings(keys)
+	return "keys(" + strings.Join(keys, ", ") + ")"
+}
+
+// KeySchema lists the allowed attribute keys and the types of their values.
+type KeySchema struct {
+	Keys map[string]string `yaml:"keys" json:"keys"`
+}
+
+type KeyTypesRule struct {
+	BaseRule
+	schemaPath string
+	schema     *KeySchema
+
+	pass     *analysis.Pass
+	local    map[string]KeyUse
+	imported map[string]importedKeyUse
+}
+
+type importedKeyUse struct {
+	KeyUse
+	pkg string
+}
+
+func NewKeyTypesRule() Rule {
+	return &KeyTypesRule{
+		BaseRule: NewBaseRule(RuleKeyTypesName, "Checks that an attribute key is logged with the same value type across the program"),
+		local:    make(map[string]KeyUse),
+	}
+}
+
+func (r *KeyTypesRule) ForPackage() Rule {
+	c := *r
+	c.local = make(map[string]KeyUse)
+	c.imported = nil
+	return &c
+}
+
+func (r *KeyTypesRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL008",
+		Options: []RuleOption{
+			{Name: "schema", Type: "string", Description: "YAML or JSON file with the allowed keys and their types"},
+		},
+		Examples: []string{`slog.Info("user created", "user_id", "42") // user_id is an int elsewhere`},
+	}
+}
+
+func (r *KeyTypesRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if path, ok := config["schema"].(string); ok && path != "" {
+		schema, err := LoadKeySchema(path)
+		if err != nil {
+			return fmt.Errorf("key_types: %w", err)
+		}
+		r.schemaPath = path
+		r.schema = schema
+	}
+
+	return nil
+}
+
+// LoadKeySchema reads a key schema from a YAML or JSON file.
+func LoadKeySchema(path string) (*KeySchema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema: %w", err)
+	}
+
+	var schema KeySchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema %s: %w", path, err)
+	}
+	return &schema, nil
+}
+
+func (r *KeyTypesRule) FactTypes() []analysis.Fact {
+	return []analysis.Fact{new(KeyTypesFact)}
+}
+
+func (r *KeyTypesRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil {
+		return ResultPass()
+	}
+	r.loadImported(ctx.Pass)
+
+	attrs, _ := ParseAttrs(ctx.TypesInfo, ctx.Args, ctx.KeyValues)
+	for _, attr := range attrs {
+		if attr.Key == "" || attr.Value == nil {
+			continue
+		}
+		typ := valueTypeString(ctx.TypesInfo, attr.Value)
+		if result := r.checkKey(ctx.Pass, attr, typ); result != nil {
+			return result
+		}
+	}
+
+	return ResultPass()
+}
+
+func (r *KeyTypesRule) checkKey(pass *analysis.Pass, attr Attr, typ string) *RuleResult {
+	if r.schema != nil {
+		want, known := r.schema.Keys[attr.Key]
+		if !known {
+			return ResultFailAt(attr.KeyExpr, fmt.Sprintf("log key %q is not in the key schema %s", attr.Key, r.schemaPath))
+		}
+		if typ != "" && want != "any" && want != typ {
+			return ResultFailAt(attr.Value, fmt.Sprintf("log key %q should have a value of type %s, got %s", attr.Key, want, typ))
+		}
+	}
+
+	if typ == "" {
+		// The static type is unknown, there is no type to compare.
+		return nil
+	}
+
+	if use, ok := r.local[attr.Key]; ok {
+		if use.Type != typ {
+			return ResultFailAt(attr.Value, fmt.Sprintf("log key %q is logged as %s here and as %s at %s", attr.Key, typ, use.Type, use.Position))
+		}
+		return nil
+	}
+	r.local[attr.Key] = KeyUse{Type: typ, Position: shortPosition(pass.Fset, attr.Value.Pos())}
+
+	if use, ok := r.imported[attr.Key]; ok && use.Type != typ {
+		return ResultFailAt(attr.Value, fmt.Sprintf("log key %q is logged as %s here and as %s in package %s at %s", attr.Key, typ, use.Type, use.pkg, use.Position))
+	}
+	return nil
+}
+
+func (r *KeyTypesRule) loadImported(pass *analysis.Pass) {
+	if r.imported != nil {
+		return
+	}
+
+	r.imported = make(map[string]importedKeyUse)
+
+	facts := pass.AllPackageFacts()
+	sort.Slice(facts, func(i, j int) bool {
+		return facts[i].Package.Path() < facts[j].Package.Path()
+	})
+	for _, pf := range facts {
+		fact, ok := pf.Fact.(*KeyTypesFact)
+		if !ok {
+			continue
+		}
+		for key, use := range fact.Keys {
+			if _, exists := r.imported[key]; !exists {
+				r.imported[key] = importedKeyUse{KeyUse: use, pkg: pf.Package.Path()}
+			}
+		}
+	}
+}
+
+func (r *KeyTypesRule) Finish(pass *analysis.Pass) {
+	if len(r.local) > 0 {
+		pass.ExportPackageFact(&KeyTypesFact{Keys: r.local})
+	}
+}
+
+// valueTypeString returns the static type of an attribute value, or an empty
+// string when the type is not known statically, as for interfaces and nil.
+func valueTypeString(info *types.Info, expr ast.Expr) string {
+	typ := info.TypeOf(expr)
+	if typ == nil || types.IsInterface(typ) || types.Identical(typ, types.Typ[types.UntypedNil]) {
+		return ""
+	}
+	return types.TypeString(types.Default(typ), (*types.Package).Path)
+}
+
+func shortPosition(fset *token.FileSet, pos token.Pos) string {
+	p := fset.Position(pos)
+	return fmt.Sprintf("%s:%d", filepath.Base(p.Filename), p.Line)
+}
@@ -0,0 +1,61 @@
+package rules
+
+import "fmt"
+
+const RuleKVPairsName = "kv_pairs"
+
+type KVPairsRule struct {
+	BaseRule
+}
+
+func NewKVPairsRule() Rule {
+	return &KVPairsRule{
+		BaseRule: NewBaseRule(RuleKVPairsName, "Checks that key-value arguments of log calls are complete pairs with unique string keys"),
+	}
+}
+
+func (r *KVPairsRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL006",
+		Examples: []string{`slog.Info("user created", "id")`, `slog.Info("user created", "id", 1, "id", 2)`},
+	}
+}
+
+func (r *KVPairsRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || !ctx.KeyValues || ctx.TypesInfo == nil {
+		return ResultPass()
+	}
+
+	chainKeys := make(map[string]bool)
+	chainAttrs, _ := ParseAttrs(ctx.TypesInfo, ctx.ChainArgs, true)
+	for _, attr := range chainAttrs {
+		if attr.Key != "" {
+			chainKeys[attr.Key] = true
+		}
+	}
+
+	attrs, bad := ParseAttrs(ctx.TypesInfo, ctx.Args, true)
+	seen := make(map[string]bool)
+	for _, attr := range attrs {
+		if attr.Key == "" {
+			continue
+		}
+		if chainKeys[attr.Key] {
+			return ResultFailAt(attr.KeyExpr, fmt.Sprintf("log key %q is already set by With", attr.Key))
+		}
+		if seen[attr.Key] {
+			return ResultFailAt(attr.KeyExpr, fmt.Sprintf("duplicate log key %q", attr.Key))
+		}
+		seen[attr.Key] = true
+	}
+
+	if bad != nil {
+		typ := ctx.TypesInfo.TypeOf(bad)
+		if isStringType(typ) {
+			return ResultFailAt(bad, "log key has no matching value")
+		}
+		return ResultFailAt(bad, fmt.Sprintf("log key should be a string or an attribute, got %s", typ))
+	}
+
+	return ResultPass()
+}
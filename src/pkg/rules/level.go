@@ -0,0 +1,32 @@
+package rules
+
+import "github.com/demidshumakher/loglinter/pkg/logcall"
+
+// Level is a log level normalized across backends.
+type Level = logcall.Level
+
+const (
+	LevelUnknown = logcall.LevelUnknown
+	LevelDebug   = logcall.LevelDebug
+	LevelInfo    = logcall.LevelInfo
+	LevelWarn    = logcall.LevelWarn
+	LevelError   = logcall.LevelError
+	LevelDPanic  = logcall.LevelDPanic
+	LevelPanic   = logcall.LevelPanic
+	LevelFatal   = logcall.LevelFatal
+)
+
+// ParseLevel returns the level with the given name, ignoring case.
+func ParseLevel(name string) (Level, bool) {
+	return logcall.ParseLevel(name)
+}
+
+// MethodLevel returns the level of a log method, see logcall.MethodLevel.
+func MethodLevel(method string) Level {
+	return logcall.MethodLevel(method)
+}
+
+// SlogLevel maps a slog.Level value to a level, see logcall.SlogLevel.
+func SlogLevel(value int64) Level {
+	return logcall.SlogLevel(value)
+}
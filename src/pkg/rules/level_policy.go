@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const RuleLevelPolicyName = "level_policy"
+
+// LevelPolicy restricts the levels allowed in the packages and files matching
+// its patterns. A policy without patterns applies everywhere.
+type LevelPolicy struct {
+	Packages []string
+	Files    []string
+	// Allow lists the allowed levels; when empty every level not denied
+	// and within Min and Max is allowed.
+	Allow []Level
+	Deny  []Level
+	Min   Level
+	Max   Level
+}
+
+type LevelPolicyRule struct {
+	BaseRule
+	policies []LevelPolicy
+}
+
+func NewLevelPolicyRule() Rule {
+	r := &LevelPolicyRule{
+		BaseRule: NewBaseRule(RuleLevelPolicyName, "Checks that log levels are allowed in the package and file"),
+	}
+	r.SetEnabled(false)
+	return r
+}
+
+func (r *LevelPolicyRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL016",
+		Options: []RuleOption{
+			{Name: "policies", Type: "[]{packages, files, allow, deny, min, max}", Description: "levels allowed per package and file pattern"},
+		},
+		Examples: []string{`logger.Debug("packet forwarded") // in a package with min: info`},
+	}
+}
+
+func (r *LevelPolicyRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if policies, ok := config["policies"].([]any); ok {
+		r.policies = make([]LevelPolicy, 0, len(policies))
+		for i, p := range policies {
+			data, ok := p.(map[string]any)
+			if !ok {
+				return fmt.Errorf("level_policy: policy at index %d is not a mapping", i)
+			}
+			policy, err := parseLevelPolicy(data)
+			if err != nil {
+				return fmt.Errorf("level_policy: %w", err)
+			}
+			r.policies = append(r.policies, policy)
+		}
+	}
+
+	return nil
+}
+
+func parseLevelPolicy(data map[string]any) (LevelPolicy, error) {
+	var policy LevelPolicy
+	var err error
+	if packages, ok := data["packages"].([]any); ok {
+		policy.Packages = toStrings(packages)
+	}
+	if files, ok := data["files"].([]any); ok {
+		policy.Files = toStrings(files)
+	}
+	if allow, ok := data["allow"].([]any); ok {
+		if policy.Allow, err = parseLevels(toStrings(allow)); err != nil {
+			return policy, err
+		}
+	}
+	if deny, ok := data["deny"].([]any); ok {
+		if policy.Deny, err = parseLevels(toStrings(deny)); err != nil {
+			return policy, err
+		}
+	}
+	for _, bound := range []struct {
+		key   string
+		level *Level
+	}{{"min", &policy.Min}, {"max", &policy.Max}} {
+		if name, ok := data[bound.key].(string); ok {
+			levels, err := parseLevels([]string{name})
+			if err != nil {
+				return policy, err
+			}
+			*bound.level = levels[0]
+		}
+	}
+	return policy, nil
+}
+
+func parseLevels(names []string) ([]Level, error) {
+	levels := make([]Level, 0, len(names))
+	for _, name := range names {
+		level, ok := ParseLevel(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown log level %q", name)
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+func (r *LevelPolicyRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil || ctx.Level == LevelUnknown {
+		return ResultPass()
+	}
+
+	pkgPath := ctx.Pass.Pkg.Path()
+	filename := ctx.Pass.Fset.Position(ctx.Call.Pos()).Filename
+
+	for _, policy := range r.policies {
+		if !policy.matches(pkgPath, filename) || policy.allows(ctx.Level) {
+			continue
+		}
+
+		where := "package " + pkgPath
+		if len(policy.Files) > 0 {
+			where = "file " + filepath.Base(filename)
+		}
+		return ResultFailAt(selectorOf(ctx.Call).Sel, fmt.Sprintf("log level %s is not allowed in %s", ctx.Level, where))
+	}
+
+	return ResultPass()
+}
+
+func (p *LevelPolicy) matches(pkgPath, filename string) bool {
+	if len(p.Packages) > 0 && !slices.ContainsFunc(p.Packages, func(pattern string) bool {
+		return MatchPackage(pattern, pkgPath)
+	}) {
+		return false
+	}
+
+	if len(p.Files) > 0 && !slices.ContainsFunc(p.Files, func(pattern string) bool {
+		return matchFile(pattern, filename)
+	}) {
+		return false
+	}
+
+	return true
+}
+
+func (p *LevelPolicy) allows(level Level) bool {
+	if len(p.Allow) > 0 && !slices.Contains(p.Allow, level) {
+		return false
+	}
+	if slices.Contains(p.Deny, level) {
+		return false
+	}
+	if p.Min != LevelUnknown && level < p.Min {
+		return false
+	}
+	if p.Max != LevelUnknown && level > p.Max {
+		return false
+	}
+	return true
+}
+
+// matchFile matches pattern against the trailing path elements of filename,
+// so "*_test.go" matches any test file and "cmd/*.go" any file in a cmd
+// directory.
+func matchFile(pattern, filename string) bool {
+	filename = filepath.ToSlash(filename)
+	for {
+		if matched, _ := path.Match(pattern, filename); matched {
+			return true
+		}
+		i := strings.IndexByte(filename, '/')
+		if i < 0 {
+			return false
+		}
+		filename = filename[i+1:]
+	}
+}
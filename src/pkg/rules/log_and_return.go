@@ -0,0 +1,150 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis/passes/ctrlflow"
+	"golang.org/x/tools/go/cfg"
+)
+
+const RuleLogAndReturnName = "log_and_return"
+
+type LogAndReturnRule struct {
+	BaseRule
+}
+
+func NewLogAndReturnRule() Rule {
+	return &LogAndReturnRule{
+		BaseRule: NewBaseRule(RuleLogAndReturnName, "Checks that an error is not both logged and returned"),
+	}
+}
+
+func (r *LogAndReturnRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL011",
+		Examples: []string{`logger.Error("failed", zap.Error(err)); return err`},
+	}
+}
+
+func (r *LogAndReturnRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil {
+		return ResultPass()
+	}
+
+	cfgs, ok := ctx.Pass.ResultOf[ctrlflow.Analyzer].(*ctrlflow.CFGs)
+	if !ok {
+		return ResultPass()
+	}
+
+	attrs, _ := ParseAttrs(ctx.TypesInfo, ctx.Args, ctx.KeyValues)
+	for _, attr := range attrs {
+		ident, ok := attr.Value.(*ast.Ident)
+		if !ok || !isError(ctx.TypesInfo.TypeOf(ident)) {
+			continue
+		}
+		errVar, ok := ctx.TypesInfo.Uses[ident].(*types.Var)
+		if !ok {
+			continue
+		}
+
+		ret := findReturnOf(enclosingCFG(cfgs, ctx.Stack), ctx.Call, ctx.TypesInfo, errVar)
+		if ret == nil {
+			continue
+		}
+
+		result := ResultFailAt(ctx.Call, fmt.Sprintf("error %s is logged and then returned, handle it once", errVar.Name()))
+		result.Related = []RelatedInformation{{Node: ret, Message: fmt.Sprintf("%s is returned here", errVar.Name())}}
+		return result
+	}
+
+	return ResultPass()
+}
+
+// enclosingCFG returns the control-flow graph of the innermost function
+// containing the last node of stack.
+func enclosingCFG(cfgs *ctrlflow.CFGs, stack []ast.Node) *cfg.CFG {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch fn := stack[i].(type) {
+		case *ast.FuncLit:
+			return cfgs.FuncLit(fn)
+		case *ast.FuncDecl:
+			return cfgs.FuncDecl(fn)
+		}
+	}
+	return nil
+}
+
+// findReturnOf follows the straight-line path starting at the statement
+// containing call and returns the first return statement that returns or
+// wraps errVar. The path ends at branches, join points and assignments to
+// errVar.
+func findReturnOf(g *cfg.CFG, call *ast.CallExpr, info *types.Info, errVar *types.Var) *ast.ReturnStmt {
+	if g == nil {
+		return nil
+	}
+
+	block, index := blockOf(g, call)
+	for block != nil {
+		for _, node := range block.Nodes[index:] {
+			switch stmt := node.(type) {
+			case *ast.ReturnStmt:
+				for _, result := range stmt.Results {
+					if usesVar(info, result, errVar) {
+						return stmt
+					}
+				}
+				return nil
+			case *ast.AssignStmt:
+				for _, lhs := range stmt.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok && info.ObjectOf(ident) == errVar {
+						return nil
+					}
+				}
+			}
+		}
+
+		if len(block.Succs) != 1 || len(predecessors(g, block.Succs[0])) != 1 {
+			return nil
+		}
+		block, index = block.Succs[0], 0
+	}
+	return nil
+}
+
+// blockOf returns the block holding the statement that contains call and
+// the index of the node following that statement.
+func blockOf(g *cfg.CFG, call *ast.CallExpr) (*cfg.Block, int) {
+	for _, block := range g.Blocks {
+		for i, node := range block.Nodes {
+			if node.Pos() <= call.Pos() && call.End() <= node.End() {
+				return block, i + 1
+			}
+		}
+	}
+	return nil, 0
+}
+
+func predecessors(g *cfg.CFG, target *cfg.Block) []*cfg.Block {
+	var preds []*cfg.Block
+	for _, block := range g.Blocks {
+		for _, succ := range block.Succs {
+			if succ == target {
+				preds = append(preds, block)
+			}
+		}
+	}
+	return preds
+}
+
+func usesVar(info *types.Info, expr ast.Expr, v *types.Var) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && info.Uses[ident] == v {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
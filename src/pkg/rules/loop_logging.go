@@ -0,0 +1,212 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+const RuleLoopLoggingName = "loop_logging"
+
+var defaultSamplers = []string{"Allow", "Sample", "Enabled"}
+
+type LoopLoggingRule struct {
+	BaseRule
+	allowLevels []Level
+	allowDebug  bool
+	samplers    []string
+}
+
+func NewLoopLoggingRule() Rule {
+	r := &LoopLoggingRule{
+		BaseRule:   NewBaseRule(RuleLoopLoggingName, "Checks that log calls inside loops are sampled or followed by an early exit"),
+		allowDebug: true,
+		samplers:   defaultSamplers,
+	}
+	r.SetEnabled(false)
+	return r
+}
+
+func (r *LoopLoggingRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL017",
+		Options: []RuleOption{
+			{Name: "allow_levels", Type: "[]string", Description: "levels allowed inside loops"},
+			{Name: "allow_debug", Type: "bool", Default: "true", Description: "allow Debug calls inside loops"},
+			{Name: "samplers", Type: "[]string", Default: strings.Join(defaultSamplers, ", "), Description: "functions whose calls in a condition sample the log call"},
+		},
+		Examples: []string{`for _, item := range items { logger.Info("processing item") }`},
+	}
+}
+
+func (r *LoopLoggingRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if levels, ok := config["allow_levels"].([]any); ok {
+		allowLevels, err := parseLevels(toStrings(levels))
+		if err != nil {
+			return fmt.Errorf("loop_logging: %w", err)
+		}
+		r.allowLevels = allowLevels
+	}
+	if allowDebug, ok := config["allow_debug"].(bool); ok {
+		r.allowDebug = allowDebug
+	}
+	if samplers, ok := config["samplers"].([]any); ok {
+		r.samplers = toStrings(samplers)
+	}
+
+	return nil
+}
+
+func (r *LoopLoggingRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Level == LevelUnknown || !r.reported(ctx.Level) {
+		return ResultPass()
+	}
+
+	loop := enclosingLoop(ctx.Stack)
+	if loop < 0 || r.guarded(ctx.Stack, loop) {
+		return ResultPass()
+	}
+
+	result := ResultFailAt(ctx.Call, fmt.Sprintf("%s log call inside a loop, sample it or move it out of the loop", ctx.Level))
+	result.Related = []RelatedInformation{{Node: ctx.Stack[loop], Message: "loop starts here"}}
+	return result
+}
+
+func (r *LoopLoggingRule) reported(level Level) bool {
+	if level == LevelDebug && r.allowDebug {
+		return false
+	}
+	return !slices.Contains(r.allowLevels, level)
+}
+
+// enclosingLoop returns the index in stack of the innermost for or range
+// statement whose body contains the last node of stack, or -1. Function
+// literals end the search: a closure defined in a loop is not called there.
+func enclosingLoop(stack []ast.Node) int {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.FuncLit, *ast.FuncDecl:
+			return -1
+		case *ast.ForStmt:
+			if stack[i+1] == node.Body {
+				return i
+			}
+		case *ast.RangeStmt:
+			if stack[i+1] == node.Body {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// guarded reports whether the last node of stack, found in the loop at
+// stack[loop], only runs on sampled iterations or is followed by a statement
+// leaving the loop.
+func (r *LoopLoggingRule) guarded(stack []ast.Node, loop int) bool {
+	// An unlabeled break inside a switch or select leaves only that statement.
+	inSwitch := false
+
+	for i := loop + 1; i < len(stack)-1; i++ {
+		var stmts []ast.Stmt
+		switch node := stack[i].(type) {
+		case *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+			inSwitch = true
+			continue
+		case *ast.IfStmt:
+			if stack[i+1] == node.Body && r.sampling(node.Cond) {
+				return true
+			}
+			continue
+		case *ast.BlockStmt:
+			stmts = node.List
+		case *ast.CaseClause:
+			stmts = node.Body
+		case *ast.CommClause:
+			stmts = node.Body
+		default:
+			continue
+		}
+
+		at := slices.IndexFunc(stmts, func(stmt ast.Stmt) bool { return stmt == stack[i+1] })
+		if at < 0 {
+			continue
+		}
+		for _, stmt := range stmts[:at] {
+			if r.samplingSkip(stmt) {
+				return true
+			}
+		}
+		for _, stmt := range stmts[at+1:] {
+			if leavesLoop(stmt, inSwitch) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// samplingSkip reports whether stmt skips the rest of the iteration on a
+// sampling condition: if i%100 != 0 { continue }.
+func (r *LoopLoggingRule) samplingSkip(stmt ast.Stmt) bool {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok || len(ifStmt.Body.List) == 0 || !r.sampling(ifStmt.Cond) {
+		return false
+	}
+	switch last := ifStmt.Body.List[len(ifStmt.Body.List)-1].(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		return last.Tok == token.CONTINUE || last.Tok == token.BREAK || last.Tok == token.GOTO
+	}
+	return false
+}
+
+// sampling reports whether cond takes a modulo or calls one of the sampler
+// functions.
+func (r *LoopLoggingRule) sampling(cond ast.Expr) bool {
+	found := false
+	ast.Inspect(cond, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.BinaryExpr:
+			if node.Op == token.REM {
+				found = true
+			}
+		case *ast.CallExpr:
+			var name string
+			switch fun := ast.Unparen(node.Fun).(type) {
+			case *ast.Ident:
+				name = fun.Name
+			case *ast.SelectorExpr:
+				name = fun.Sel.Name
+			}
+			if slices.Contains(r.samplers, name) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func leavesLoop(stmt ast.Stmt, inSwitch bool) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		switch stmt.Tok {
+		case token.GOTO:
+			return true
+		case token.BREAK:
+			return stmt.Label != nil || !inSwitch
+		}
+	}
+	return false
+}
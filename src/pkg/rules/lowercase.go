@@ -16,6 +16,13 @@ func NewLowercaseRule() Rule {
 	}
 }
 
+func (r *LowercaseRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL001",
+		Examples: []string{`slog.Info("Starting server")`},
+	}
+}
+
 func (r *LowercaseRule) Check(ctx *CheckContext) *RuleResult {
 	if !r.Enabled() {
 		return ResultPass()
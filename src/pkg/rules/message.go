@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Segment is a string literal the log message is built from.
+type Segment struct {
+	Lit *ast.BasicLit
+	// Value is the unquoted value of the literal.
+	Value string
+	// Src is the literal as written in the file. It differs from Lit.Value
+	// only for raw strings with carriage returns, which the parser drops.
+	// Empty means Lit.Value.
+	Src string
+}
+
+// Pos returns the position of the opening quote of the literal.
+func (s Segment) Pos() token.Pos { return s.Lit.Pos() }
+
+// End returns the position right after the closing quote of the literal.
+func (s Segment) End() token.Pos { return s.Lit.End() }
+
+// MessageSegments returns the string literals of a message built by
+// concatenation, in source order: "user " + name + " logged in" has the
+// segments "user " and " logged in".
+func MessageSegments(expr ast.Expr) []Segment {
+	var segments []Segment
+	var walk func(ast.Expr)
+	walk = func(e ast.Expr) {
+		switch v := e.(type) {
+		case *ast.BasicLit:
+			if v.Kind != token.STRING {
+				return
+			}
+			if value, err := strconv.Unquote(v.Value); err == nil {
+				segments = append(segments, Segment{Lit: v, Value: value})
+			}
+		case *ast.BinaryExpr:
+			if v.Op == token.ADD {
+				walk(v.X)
+				walk(v.Y)
+			}
+		case *ast.ParenExpr:
+			walk(v.X)
+		}
+	}
+	if expr != nil {
+		walk(expr)
+	}
+	return segments
+}
+
+// Range returns the source positions of the bytes start to end of Value. An
+// escape sequence is mapped as a whole: a range starting or ending inside the
+// bytes \u00e9 decodes to covers the escape.
+func (s Segment) Range(start, end int) (token.Pos, token.Pos) {
+	src := s.Src
+	if src == "" {
+		src = s.Lit.Value
+	}
+	raw := src[0] == '`'
+	base := s.Lit.Pos() + 1
+
+	startPos, endPos := token.NoPos, token.NoPos
+	rest := src[1 : len(src)-1]
+	srcOff, valueOff := 0, 0
+	for rest != "" {
+		srcLen, valueLen := 1, 1
+		if raw {
+			// Carriage returns of raw strings are not in the value.
+			if rest[0] == '\r' {
+				valueLen = 0
+			}
+		} else {
+			value, multibyte, tail, err := strconv.UnquoteChar(rest, '"')
+			if err != nil {
+				break
+			}
+			srcLen = len(rest) - len(tail)
+			valueLen = srcLen
+			if rest[0] == '\\' {
+				valueLen = 1
+				if multibyte {
+					valueLen = utf8.RuneLen(value)
+				}
+			}
+		}
+
+		if !startPos.IsValid() && start < valueOff+valueLen {
+			startPos = base + token.Pos(srcOff)
+		}
+		if end <= valueOff+valueLen {
+			endPos = base + token.Pos(srcOff+srcLen)
+			if end == valueOff {
+				endPos = base + token.Pos(srcOff)
+			}
+			break
+		}
+
+		srcOff += srcLen
+		valueOff += valueLen
+		rest = rest[srcLen:]
+	}
+
+	if !endPos.IsValid() {
+		endPos = base + token.Pos(srcOff)
+	}
+	if !startPos.IsValid() {
+		// An empty range after the last byte covered.
+		startPos = endPos
+	}
+	return startPos, endPos
+}
@@ -0,0 +1,189 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const RuleNoErrorStringName = "no_error_string"
+
+var trailingVerb = regexp.MustCompile(`%[vsw]$`)
+
+type NoErrorStringRule struct {
+	BaseRule
+}
+
+func NewNoErrorStringRule() Rule {
+	return &NoErrorStringRule{
+		BaseRule: NewBaseRule(RuleNoErrorStringName, "Checks that errors are logged as attributes rather than formatted into strings"),
+	}
+}
+
+func (r *NoErrorStringRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL012",
+		Examples: []string{`slog.Error("failed: " + err.Error())`, `logger.Error("failed", zap.String("err", err.Error()))`},
+	}
+}
+
+func (r *NoErrorStringRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil {
+		return ResultPass()
+	}
+
+	if errExpr := stringifiedError(ctx.TypesInfo, ctx.MsgExpr); errExpr != nil {
+		result := ResultFail("log message should not contain a formatted error, pass it as an attribute")
+		result.SuggestedFix = messageErrorFix(ctx, errExpr)
+		return result
+	}
+
+	attrs, _ := ParseAttrs(ctx.TypesInfo, ctx.Args, ctx.KeyValues)
+	for _, attr := range attrs {
+		if attr.Value == nil {
+			continue
+		}
+		errExpr := stringifiedError(ctx.TypesInfo, attr.Value)
+		if errExpr == nil {
+			continue
+		}
+
+		result := ResultFailAt(attr.Value, "log attribute should hold the error itself rather than its string")
+		result.SuggestedFix = attrErrorFix(ctx, attr, errExpr)
+		return result
+	}
+
+	return ResultPass()
+}
+
+// stringifiedError returns the error expression when expr turns an error
+// into a string: err.Error(), fmt.Sprint(err) or fmt.Sprintf("...%v", err),
+// possibly concatenated with other strings.
+func stringifiedError(info *types.Info, expr ast.Expr) ast.Expr {
+	switch v := ast.Unparen(expr).(type) {
+	case *ast.BinaryExpr:
+		if v.Op != token.ADD {
+			return nil
+		}
+		if errExpr := stringifiedError(info, v.X); errExpr != nil {
+			return errExpr
+		}
+		return stringifiedError(info, v.Y)
+	case *ast.CallExpr:
+		if sel, ok := v.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Error" && len(v.Args) == 0 {
+			if isError(info.TypeOf(sel.X)) {
+				return sel.X
+			}
+		}
+		fn := calledFunc(info, v)
+		if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+			return nil
+		}
+		if fn.Name() != "Sprint" && fn.Name() != "Sprintf" && fn.Name() != "Sprintln" {
+			return nil
+		}
+		for _, arg := range v.Args {
+			if isError(info.TypeOf(arg)) {
+				return arg
+			}
+		}
+	}
+	return nil
+}
+
+// messageErrorFix handles the common shapes "prefix: " + err.Error() and
+// fmt.Sprintf("prefix: %v", err): the prefix becomes the message and the
+// error is appended as an attribute.
+func messageErrorFix(ctx *CheckContext, errExpr ast.Expr) *SuggestedFix {
+	var prefix ast.Expr
+	switch v := ast.Unparen(ctx.MsgExpr).(type) {
+	case *ast.BinaryExpr:
+		if stringifiedError(ctx.TypesInfo, v.X) == nil {
+			prefix = v.X
+		}
+	case *ast.CallExpr:
+		if len(v.Args) == 2 && v.Args[1] == errExpr {
+			prefix = v.Args[0]
+		}
+	}
+
+	lit, ok := prefix.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+	text, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return nil
+	}
+	if _, isCall := ast.Unparen(ctx.MsgExpr).(*ast.CallExpr); isCall {
+		if !trailingVerb.MatchString(text) || strings.Contains(text[:len(text)-2], "%") {
+			return nil
+		}
+		text = text[:len(text)-2]
+	}
+	text = strings.TrimRight(text, " :-")
+
+	attr := errorAttrText(ctx, types.ExprString(errExpr))
+	if attr == "" || text == "" {
+		return nil
+	}
+
+	return &SuggestedFix{
+		Message: "Pass the error as an attribute",
+		Edits: []TextEdit{{
+			Pos:     ctx.MsgExpr.Pos(),
+			End:     ctx.MsgExpr.End(),
+			NewText: strconv.Quote(text),
+		}, {
+			Pos:     ctx.Call.Args[len(ctx.Call.Args)-1].End(),
+			End:     ctx.Call.Args[len(ctx.Call.Args)-1].End(),
+			NewText: ", " + attr,
+		}},
+	}
+}
+
+// attrErrorFix replaces a string attribute holding a formatted error with an
+// error attribute: zap.String("error", err.Error()) becomes zap.Error(err),
+// slog.String(k, err.Error()) becomes slog.Any(k, err) and the value of a
+// key-value pair becomes err. The constructor is taken from the package of
+// the replaced one, as zap fields are also passed to slog-style sugared
+// methods.
+func attrErrorFix(ctx *CheckContext, attr Attr, errExpr ast.Expr) *SuggestedFix {
+	errText := types.ExprString(errExpr)
+	target, newText := attr.Value, errText
+
+	if attr.KeyExpr != nil && attr.Arg != attr.KeyExpr {
+		call, ok := ast.Unparen(attr.Arg).(*ast.CallExpr)
+		if !ok {
+			return nil
+		}
+		fn := calledFunc(ctx.TypesInfo, call)
+		if fn == nil || fn.Pkg() == nil {
+			return nil
+		}
+
+		keyText := types.ExprString(attr.KeyExpr)
+		switch pkg := fn.Pkg().Path(); {
+		case pkg == zapPackage && attr.Key == "error":
+			newText = importName(ctx.Pass, ctx.Call, zapPackage) + ".Error(" + errText + ")"
+		case pkg == zapPackage:
+			newText = importName(ctx.Pass, ctx.Call, zapPackage) + ".NamedError(" + keyText + ", " + errText + ")"
+		case pkg == slogPackage:
+			newText = importName(ctx.Pass, ctx.Call, slogPackage) + ".Any(" + keyText + ", " + errText + ")"
+		default:
+			return nil
+		}
+		if strings.HasPrefix(newText, ".") {
+			return nil
+		}
+		target = attr.Arg
+	}
+
+	return &SuggestedFix{
+		Message: "Pass the error itself",
+		Edits:   []TextEdit{{Pos: target.Pos(), End: target.End(), NewText: newText}},
+	}
+}
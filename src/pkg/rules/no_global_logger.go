@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const RuleNoGlobalLoggerName = "no_global_logger"
+
+// globalLoggerFuncs maps package paths to the functions returning the global
+// logger of that package.
+var globalLoggerFuncs = map[string][]string{
+	slogPackage: {"Default"},
+	zapPackage:  {"L", "S"},
+	logPackage:  {"Default"},
+}
+
+var injectedLoggers = map[Backend]string{
+	BackendSlog:     "*slog.Logger",
+	BackendZap:      "*zap.Logger",
+	BackendZapSugar: "*zap.SugaredLogger",
+	BackendLog:      "*log.Logger",
+}
+
+// GlobalLoggerPackage is a package pattern where global loggers are banned.
+type GlobalLoggerPackage struct {
+	Pattern string
+	// Logger is the injected logger type to mention in reports. When empty
+	// the logger type of the reported backend is used.
+	Logger string
+}
+
+type NoGlobalLoggerRule struct {
+	BaseRule
+	packages []GlobalLoggerPackage
+}
+
+func NewNoGlobalLoggerRule() Rule {
+	r := &NoGlobalLoggerRule{
+		BaseRule: NewBaseRule(RuleNoGlobalLoggerName, "Checks that selected packages use injected loggers instead of global ones"),
+	}
+	r.SetEnabled(false)
+	return r
+}
+
+func (r *NoGlobalLoggerRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL014",
+		Options: []RuleOption{
+			{Name: "packages", Type: "[]string | []{pattern, logger}", Description: "packages where global loggers are banned, all packages when empty"},
+		},
+		Examples: []string{`slog.Info("user created")`, `zap.L().Info("user created")`},
+	}
+}
+
+func (r *NoGlobalLoggerRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if packages, ok := config["packages"].([]any); ok {
+		r.packages = make([]GlobalLoggerPackage, 0, len(packages))
+		for _, p := range packages {
+			switch v := p.(type) {
+			case string:
+				r.packages = append(r.packages, GlobalLoggerPackage{Pattern: v})
+			case map[string]any:
+				pattern, _ := v["pattern"].(string)
+				logger, _ := v["logger"].(string)
+				r.packages = append(r.packages, GlobalLoggerPackage{Pattern: pattern, Logger: logger})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *NoGlobalLoggerRule) ChecksStdLog() bool { return true }
+
+func (r *NoGlobalLoggerRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil || !ctx.PackageLevel {
+		return ResultPass()
+	}
+
+	pkg, ok := r.matchPackage(ctx.Pass.Pkg.Path())
+	if !ok {
+		return ResultPass()
+	}
+
+	sel := selectorOf(ctx.Call)
+	return ResultFailAt(ctx.Call, fmt.Sprintf("global logger %s.%s is not allowed here, inject a %s",
+		types.ExprString(sel.X), sel.Sel.Name, r.expectedLogger(pkg, ctx.Backend)))
+}
+
+// Finish reports calls returning the global logger, such as slog.Default()
+// or zap.L(), since they are not log calls themselves.
+func (r *NoGlobalLoggerRule) Finish(pass *analysis.Pass) {
+	if !r.Enabled() {
+		return
+	}
+	pkg, ok := r.matchPackage(pass.Pkg.Path())
+	if !ok {
+		return
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		fn := calledFunc(pass.TypesInfo, call)
+		if fn == nil || fn.Pkg() == nil || fn.Type().(*types.Signature).Recv() != nil {
+			return
+		}
+		if !slices.Contains(globalLoggerFuncs[fn.Pkg().Path()], fn.Name()) {
+			return
+		}
+
+		backend := Backend(fn.Pkg().Name())
+		if fn.Pkg().Path() == zapPackage && fn.Name() == "S" {
+			backend = BackendZapSugar
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:      call.Pos(),
+			End:      call.End(),
+			Category: r.Name(),
+			Message: fmt.Sprintf("global logger %s.%s() is not allowed here, inject a %s",
+				fn.Pkg().Name(), fn.Name(), r.expectedLogger(pkg, backend)),
+		})
+	})
+}
+
+func (r *NoGlobalLoggerRule) matchPackage(pkgPath string) (GlobalLoggerPackage, bool) {
+	if len(r.packages) == 0 {
+		return GlobalLoggerPackage{}, true
+	}
+	for _, pkg := range r.packages {
+		if MatchPackage(pkg.Pattern, pkgPath) {
+			return pkg, true
+		}
+	}
+	return GlobalLoggerPackage{}, false
+}
+
+func (r *NoGlobalLoggerRule) expectedLogger(pkg GlobalLoggerPackage, backend Backend) string {
+	if pkg.Logger != "" {
+		return pkg.Logger
+	}
+	return injectedLoggers[backend]
+}
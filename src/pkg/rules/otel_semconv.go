@@ -0,0 +1,141 @@
+package rules
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"strings"
+	"sync"
+)
+
+const RuleOTelSemconvName = "otel_semconv"
+
+//go:embed semconv.json
+var semconvData []byte
+
+// Semconv is a snapshot of OpenTelemetry semantic convention attributes.
+type Semconv struct {
+	Version string `json:"version"`
+	// Attributes maps attribute names to their types: string, int, double,
+	// boolean or an array of them such as string[].
+	Attributes map[string]string `json:"attributes"`
+	// Deprecated maps deprecated attribute names to their replacements.
+	Deprecated map[string]string `json:"deprecated"`
+}
+
+var loadSemconv = sync.OnceValue(func() *Semconv {
+	var sc Semconv
+	if err := json.Unmarshal(semconvData, &sc); err != nil {
+		panic(fmt.Sprintf("otel_semconv: invalid embedded snapshot: %v", err))
+	}
+	return &sc
+})
+
+type OTelSemconvRule struct {
+	BaseRule
+	semconv *Semconv
+	// byNormalized maps normalized spellings of semconv and deprecated names
+	// to the canonical attribute name.
+	byNormalized map[string]string
+}
+
+func NewOTelSemconvRule() Rule {
+	r := &OTelSemconvRule{
+		BaseRule:     NewBaseRule(RuleOTelSemconvName, "Checks attribute keys against OpenTelemetry semantic conventions"),
+		semconv:      loadSemconv(),
+		byNormalized: make(map[string]string),
+	}
+	r.SetEnabled(false)
+
+	for name := range r.semconv.Attributes {
+		r.byNormalized[normalizeKey(name)] = name
+	}
+	for name, replacement := range r.semconv.Deprecated {
+		r.byNormalized[normalizeKey(name)] = replacement
+	}
+	return r
+}
+
+func (r *OTelSemconvRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL009",
+		Examples: []string{`slog.Info("request handled", "http.method", method)`},
+	}
+}
+
+func (r *OTelSemconvRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.TypesInfo == nil {
+		return ResultPass()
+	}
+
+	attrs, _ := ParseAttrs(ctx.TypesInfo, ctx.Args, ctx.KeyValues)
+	for _, attr := range attrs {
+		if attr.Key == "" {
+			continue
+		}
+
+		if want, ok := r.semconv.Attributes[attr.Key]; ok {
+			if attr.Value == nil {
+				continue
+			}
+			if got := semconvType(ctx.TypesInfo.TypeOf(attr.Value)); got != "" && got != want {
+				return ResultFailAt(attr.Value, fmt.Sprintf("semantic convention attribute %q should be %s, got %s", attr.Key, want, got))
+			}
+			continue
+		}
+
+		canonical, ok := r.byNormalized[normalizeKey(attr.Key)]
+		if !ok {
+			continue
+		}
+
+		message := fmt.Sprintf("log key %q should be the semantic convention attribute %q", attr.Key, canonical)
+		result := ResultFailAt(attr.KeyExpr, message)
+		if isBasicLit(attr.KeyExpr) {
+			result.SuggestedFix = &SuggestedFix{
+				Message: fmt.Sprintf("Rename to %s", canonical),
+				NewText: canonical,
+			}
+		}
+		return result
+	}
+
+	return ResultPass()
+}
+
+// normalizeKey drops case and separators so that http_method, httpMethod and
+// http.method compare equal.
+func normalizeKey(key string) string {
+	return strings.Join(splitKeyWords(key), "")
+}
+
+// semconvType maps a Go type to the semantic convention type names, or
+// returns an empty string when the type has no counterpart.
+func semconvType(t types.Type) string {
+	if t == nil {
+		return ""
+	}
+	if slice, ok := t.Underlying().(*types.Slice); ok {
+		if elem := semconvType(slice.Elem()); elem != "" && !strings.HasSuffix(elem, "[]") {
+			return elem + "[]"
+		}
+		return ""
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return ""
+	}
+	switch info := basic.Info(); {
+	case info&types.IsString != 0:
+		return "string"
+	case info&types.IsInteger != 0:
+		return "int"
+	case info&types.IsFloat != 0:
+		return "double"
+	case info&types.IsBoolean != 0:
+		return "boolean"
+	}
+	return ""
+}
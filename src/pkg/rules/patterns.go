@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"math"
+	"path"
+	"strings"
+)
+
+// MatchPackage reports whether pkgPath matches pattern. A pattern ending in
+// "/..." matches the package and all packages below it, other patterns are
+// matched with path.Match.
+func MatchPackage(pattern, pkgPath string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/")
+	}
+	matched, _ := path.Match(pattern, pkgPath)
+	return matched
+}
+
+func toStrings(values []any) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// toInt converts a whole number decoded from YAML, JSON or the golangci-lint
+// settings, where numbers may be float64, to an int.
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case uint:
+		return int(n), true
+	case uint32:
+		return int(n), true
+	case uint64:
+		return int(n), true
+	case float32:
+		return toInt(float64(n))
+	case float64:
+		if n == math.Trunc(n) {
+			return int(n), true
+		}
+	}
+	return 0, false
+}
@@ -4,8 +4,11 @@ import (
 	"fmt"
 )
 
+// RuleRegistry keeps rule builders in registration order, which is also the
+// order rules check a log call in.
 type RuleRegistry struct {
 	builders map[string]RuleBuilder
+	names    []string
 }
 
 func NewRuleRegistry() *RuleRegistry {
@@ -25,6 +28,7 @@ func (r *RuleRegistry) Register(name string, builder RuleBuilder) error {
 	}
 
 	r.builders[name] = builder
+	r.names = append(r.names, name)
 	return nil
 }
 
@@ -36,18 +40,47 @@ func (r *RuleRegistry) Get(name string) (Rule, error) {
 	return builder(), nil
 }
 
+// GetAll builds every registered rule in registration order.
 func (r *RuleRegistry) GetAll() ([]Rule, error) {
-	rules := make([]Rule, 0, len(r.builders))
-	for _, builder := range r.builders {
-		rules = append(rules, builder())
+	rules := make([]Rule, 0, len(r.names))
+	for _, name := range r.names {
+		rules = append(rules, r.builders[name]())
 	}
 	return rules, nil
 }
 
+// Describe lists every registered rule in registration order.
+func (r *RuleRegistry) Describe() []RuleDescription {
+	all, _ := r.GetAll()
+	descriptions := make([]RuleDescription, 0, len(all))
+	for _, rule := range all {
+		desc := RuleDescription{
+			Name:        rule.Name(),
+			Description: rule.Description(),
+			Enabled:     rule.Enabled(),
+		}
+		if documented, ok := rule.(DocumentedRule); ok {
+			desc.RuleDoc = documented.Doc()
+		}
+		descriptions = append(descriptions, desc)
+	}
+	return descriptions
+}
+
 func RegisterRule(name string, builder RuleBuilder) error {
 	return globalRegistry.Register(name, builder)
 }
 
+// GetRule builds the registered rule with the given name.
+func GetRule(name string) (Rule, error) {
+	return globalRegistry.Get(name)
+}
+
 func GetAllRules() ([]Rule, error) {
 	return globalRegistry.GetAll()
 }
+
+// DescribeRules lists the registered rules.
+func DescribeRules() []RuleDescription {
+	return globalRegistry.Describe()
+}
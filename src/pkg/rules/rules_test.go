@@ -2,6 +2,9 @@ package rules
 
 import (
 	"go/ast"
+	"go/parser"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -220,3 +223,212 @@ func TestResultHelpers(t *testing.T) {
 		}
 	})
 }
+
+func TestCheckKeyStyle(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		style     string
+		wantValid bool
+		wantFix   string
+	}{
+		{"valid snake", "user_id", KeyStyleSnake, true, ""},
+		{"camel to snake", "userID", KeyStyleSnake, false, "user_id"},
+		{"acronym to snake", "HTTPStatusCode", KeyStyleSnake, false, "http_status_code"},
+		{"kebab to snake", "user-id", KeyStyleSnake, false, "user_id"},
+		{"valid camel", "userId", KeyStyleCamel, true, ""},
+		{"snake to camel", "user_id", KeyStyleCamel, false, "userId"},
+		{"valid kebab", "user-id", KeyStyleKebab, true, ""},
+		{"camel to kebab", "requestID", KeyStyleKebab, false, "request-id"},
+		{"valid dotted", "http.status_code", KeyStyleDotted, true, ""},
+		{"camel to dotted", "httpMethod", KeyStyleDotted, false, "http.method"},
+		{"no words", "__", KeyStyleSnake, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, fix := CheckKeyStyle(tt.key, tt.style)
+			if valid != tt.wantValid {
+				t.Errorf("CheckKeyStyle(%q, %q) valid = %v, want %v", tt.key, tt.style, valid, tt.wantValid)
+			}
+			if fix != tt.wantFix {
+				t.Errorf("CheckKeyStyle(%q, %q) fix = %q, want %q", tt.key, tt.style, fix, tt.wantFix)
+			}
+		})
+	}
+}
+
+func TestMatchPackage(t *testing.T) {
+	tests := []struct {
+		pattern string
+		pkgPath string
+		want    bool
+	}{
+		{"example.com/lib/...", "example.com/lib", true},
+		{"example.com/lib/...", "example.com/lib/store", true},
+		{"example.com/lib/...", "example.com/library", false},
+		{"example.com/internal/*", "example.com/internal/store", true},
+		{"example.com/internal/*", "example.com/internal/store/sql", false},
+		{"example.com/cmd/app", "example.com/cmd/app", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.pkgPath, func(t *testing.T) {
+			if got := MatchPackage(tt.pattern, tt.pkgPath); got != tt.want {
+				t.Errorf("MatchPackage(%q, %q) = %v, want %v", tt.pattern, tt.pkgPath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMethodLevel(t *testing.T) {
+	tests := []struct {
+		method string
+		want   Level
+	}{
+		{"Debug", LevelDebug},
+		{"InfoContext", LevelInfo},
+		{"Warnw", LevelWarn},
+		{"Errorf", LevelError},
+		{"DPanicw", LevelDPanic},
+		{"Println", LevelInfo},
+		{"Fatalln", LevelFatal},
+		{"With", LevelUnknown},
+		{"Log", LevelUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			if got := MethodLevel(tt.method); got != tt.want {
+				t.Errorf("MethodLevel(%q) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleRegistryOrder(t *testing.T) {
+	registry := NewRuleRegistry()
+	names := []string{RuleSensitiveWordsName, RuleLowercaseName, RuleLoopLoggingName, RuleEnglishOnlyName}
+	builders := map[string]RuleBuilder{
+		RuleSensitiveWordsName: NewSensitiveWordsRule,
+		RuleLowercaseName:      NewLowercaseRule,
+		RuleLoopLoggingName:    NewLoopLoggingRule,
+		RuleEnglishOnlyName:    NewEnglishOnlyRule,
+	}
+	for _, name := range names {
+		if err := registry.Register(name, builders[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := registry.Register(RuleLowercaseName, NewLowercaseRule); err == nil {
+		t.Error("Register() accepted a duplicate rule")
+	}
+
+	for i := 0; i < 10; i++ {
+		all, _ := registry.GetAll()
+		for j, rule := range all {
+			if rule.Name() != names[j] {
+				t.Fatalf("GetAll()[%d] = %s, want %s", j, rule.Name(), names[j])
+			}
+		}
+	}
+
+	descriptions := registry.Describe()
+	if len(descriptions) != len(names) {
+		t.Fatalf("Describe() returned %d rules, want %d", len(descriptions), len(names))
+	}
+	loop := descriptions[2]
+	if loop.Name != RuleLoopLoggingName || loop.Code != "LL017" || loop.Enabled || len(loop.Options) != 3 || len(loop.Examples) == 0 {
+		t.Errorf("Describe()[2] = %+v", loop)
+	}
+}
+
+func TestMessageSegments(t *testing.T) {
+	expr, err := parser.ParseExpr(`"user " + name + ("\tlogged" + ` + "`in`" + `) + 42`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, seg := range MessageSegments(expr) {
+		got = append(got, seg.Value)
+	}
+	want := []string{"user ", "\tlogged", "in"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MessageSegments() = %q, want %q", got, want)
+	}
+}
+
+func TestSegmentRange(t *testing.T) {
+	tests := []struct {
+		lit        string
+		start, end int
+		want       string
+	}{
+		{`"server started!!"`, 14, 16, `!!`},
+		{`"done ✅ again"`, 5, 8, `✅`},
+		{`"done ✅ again"`, 6, 7, `✅`},
+		{`"tab\there!"`, 8, 9, `!`},
+		{`"\x41\101 ok"`, 0, 2, `\x41\101`},
+		{`"é!"`, 2, 3, `!`},
+		{"`raw\\n!`", 5, 6, `!`},
+		{`"end"`, 3, 3, ``},
+		{"`first\r\nsecond!`", 12, 13, `!`},
+		{"`a\r\n\r\nb`", 1, 3, "\n\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lit, func(t *testing.T) {
+			expr, err := parser.ParseExpr(tt.lit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			segments := MessageSegments(expr)
+			if len(segments) != 1 {
+				t.Fatalf("MessageSegments() returned %d segments, want 1", len(segments))
+			}
+			if strings.Contains(tt.lit, "\r") {
+				// The parser drops carriage returns of raw strings.
+				segments[0].Src = tt.lit
+			}
+
+			pos, end := segments[0].Range(tt.start, tt.end)
+			base := expr.Pos()
+			if got := tt.lit[pos-base : end-base]; got != tt.want {
+				t.Errorf("Range(%d, %d) covers %q, want %q", tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpecialCharRanges(t *testing.T) {
+	got := SpecialCharRanges("done 🚀 now!! ok")
+	want := [][2]int{{5, 9}, {13, 15}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SpecialCharRanges() = %v, want %v", got, want)
+	}
+}
+
+func TestConfigureErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   RuleBuilder
+		config map[string]any
+	}{
+		{"attr_keys style", NewAttrKeysRule, map[string]any{"style": "pascal"}},
+		{"catalog path", NewCatalogRule, map[string]any{"path": "testdata/missing.yaml"}},
+		{"key_types schema", NewKeyTypesRule, map[string]any{"schema": "testdata/missing.yaml"}},
+		{"level_policy mapping", NewLevelPolicyRule, map[string]any{"policies": []any{"info"}}},
+		{"level_policy level", NewLevelPolicyRule, map[string]any{"policies": []any{map[string]any{"min": "verbose"}}}},
+		{"loop_logging level", NewLoopLoggingRule, map[string]any{"allow_levels": []any{"trace"}}},
+		{"unique_messages min_length", NewUniqueMessagesRule, map[string]any{"min_length": 2.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule().Configure(tt.config); err == nil {
+				t.Errorf("Configure(%v) returned no error", tt.config)
+			}
+		})
+	}
+}
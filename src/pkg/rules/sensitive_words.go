@@ -3,6 +3,7 @@ package rules
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 	"strings"
 )
 
@@ -37,6 +38,16 @@ func NewSensitiveWordsRule() Rule {
 	}
 }
 
+func (r *SensitiveWordsRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL004",
+		Options: []RuleOption{
+			{Name: "words", Type: "[]string", Default: strings.Join(DefaultSensitiveWords, ", "), Description: "variable names that must not be logged"},
+		},
+		Examples: []string{`slog.Info("user password: " + password)`},
+	}
+}
+
 func (r *SensitiveWordsRule) Configure(config map[string]any) error {
 	if err := r.BaseRule.Configure(config); err != nil {
 		return err
@@ -55,58 +66,46 @@ func (r *SensitiveWordsRule) Configure(config map[string]any) error {
 }
 
 func (r *SensitiveWordsRule) Check(ctx *CheckContext) *RuleResult {
-	if !r.Enabled() {
+	if !r.Enabled() || ctx.MsgExpr == nil {
 		return ResultPass()
 	}
 
-	if sensitiveVar := r.findSensitiveVar(ctx.MsgExpr); sensitiveVar != "" {
-		return ResultFail(fmt.Sprintf("log message contains sensitive variable: %s", sensitiveVar))
+	idents := r.findSensitiveVars(ctx.TypesInfo, ctx.MsgExpr)
+	if len(idents) == 0 {
+		return ResultPass()
 	}
+	for _, ident := range idents[1:] {
+		ctx.Report(sensitiveVarResult(ident))
+	}
+	return sensitiveVarResult(idents[0])
+}
 
-	return ResultPass()
+func sensitiveVarResult(ident *ast.Ident) *RuleResult {
+	return ResultFailAt(ident, fmt.Sprintf("log message contains sensitive variable: %s", ident.Name))
 }
 
-func (r *SensitiveWordsRule) findSensitiveVar(expr ast.Expr) string {
-	var walk func(ast.Expr) string
-	walk = func(e ast.Expr) string {
-		switch v := e.(type) {
-		case *ast.Ident:
-			if r.isSensitiveWord(v.Name) {
-				return v.Name
-			}
-		case *ast.SelectorExpr:
-			if r.isSensitiveWord(v.Sel.Name) {
-				return v.Sel.Name
-			}
-		case *ast.BinaryExpr:
-			if result := walk(v.X); result != "" {
-				return result
-			}
-			return walk(v.Y)
-		case *ast.StarExpr:
-			return walk(v.X)
-		case *ast.UnaryExpr:
-			return walk(v.X)
-		case *ast.CallExpr:
-			for _, arg := range v.Args {
-				if result := walk(arg); result != "" {
-					return result
-				}
-			}
-			if sel, ok := v.Fun.(*ast.SelectorExpr); ok {
-				if r.isSensitiveWord(sel.Sel.Name) {
-					return sel.Sel.Name
-				}
-			}
-			if ident, ok := v.Fun.(*ast.Ident); ok {
-				if r.isSensitiveWord(ident.Name) {
-					return ident.Name
-				}
+// findSensitiveVars returns the variables, fields and functions with a
+// sensitive name used in expr. Constants, types and package names are not
+// values computed at run time and are skipped. Without type information
+// every identifier is considered.
+func (r *SensitiveWordsRule) findSensitiveVars(info *types.Info, expr ast.Expr) []*ast.Ident {
+	var found []*ast.Ident
+	ast.Inspect(expr, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok || !r.isSensitiveWord(ident.Name) {
+			return true
+		}
+		if info != nil {
+			switch info.ObjectOf(ident).(type) {
+			case *types.Var, *types.Func, nil:
+			default:
+				return true
 			}
 		}
-		return ""
-	}
-	return walk(expr)
+		found = append(found, ident)
+		return true
+	})
+	return found
 }
 
 func (r *SensitiveWordsRule) isSensitiveWord(word string) bool {
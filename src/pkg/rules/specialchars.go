@@ -1,7 +1,9 @@
 package rules
 
 import (
+	"regexp"
 	"slices"
+	"strings"
 	"unicode"
 )
 
@@ -9,6 +11,10 @@ const RuleNoSpecialCharsName = "no_special_chars"
 
 var allowedPunctuation = []rune{'.', ',', ':', ';', '-', '_', '/', '\\', '(', ')', '[', ']', '{', '}', '=', '+', '"', '\'', '<', '>', '?'}
 
+var formatVerb = regexp.MustCompile(`%[-+# 0]*[0-9*]*(\.[0-9*]*)?[a-zA-Z%]`)
+
+const specialCharsMessage = "log message should not contain special characters or emojis"
+
 type NoSpecialCharsRule struct {
 	BaseRule
 }
@@ -19,11 +25,29 @@ func NewNoSpecialCharsRule() Rule {
 	}
 }
 
+func (r *NoSpecialCharsRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL002",
+		Examples: []string{`slog.Info("server started!!!")`, `slog.Info("done 🚀")`},
+	}
+}
+
 func (r *NoSpecialCharsRule) Check(ctx *CheckContext) *RuleResult {
 	if !r.Enabled() {
 		return ResultPass()
 	}
 
+	if result := r.reportRanges(ctx); result != nil {
+		return result
+	}
+
+	if ctx.Format {
+		if valid, _ := CheckNoSpecialChars(formatVerb.ReplaceAllString(ctx.Msg, "")); !valid {
+			return ResultFail(specialCharsMessage)
+		}
+		return ResultPass()
+	}
+
 	valid, _ := CheckNoSpecialChars(ctx.Msg)
 	if valid {
 		return ResultPass()
@@ -31,12 +55,85 @@ func (r *NoSpecialCharsRule) Check(ctx *CheckContext) *RuleResult {
 
 	cleanedMsg := cleanSpecialChars(ctx.Msg)
 	return ResultFailWithSuggestion(
-		"log message should not contain special characters or emojis",
+		specialCharsMessage,
 		"Remove special characters",
 		cleanedMsg,
 	)
 }
 
+// reportRanges reports every run of special characters in the literals of the
+// message, each with a fix removing it, and returns the first report. It
+// returns nil when no run is found; the whole message is checked then, which
+// also catches three dots far apart.
+func (r *NoSpecialCharsRule) reportRanges(ctx *CheckContext) *RuleResult {
+	var results []*RuleResult
+	for _, seg := range ctx.Segments {
+		value := seg.Value
+		if ctx.Format {
+			// Blank the verbs out, keeping the offsets of the other bytes.
+			value = formatVerb.ReplaceAllStringFunc(value, func(verb string) string {
+				return strings.Repeat(" ", len(verb))
+			})
+		}
+		for _, run := range SpecialCharRanges(value) {
+			pos, end := seg.Range(run[0], run[1])
+			result := ResultFailRange(pos, end, specialCharsMessage)
+			result.SuggestedFix = &SuggestedFix{
+				Message: "Remove special characters",
+				Edits:   []TextEdit{{Pos: pos, End: end}},
+			}
+			results = append(results, result)
+		}
+	}
+
+	if len(results) == 0 {
+		return nil
+	}
+	for _, result := range results[1:] {
+		ctx.Report(result)
+	}
+	return results[0]
+}
+
+// SpecialCharRanges returns the byte ranges of the runs of characters that
+// CheckNoSpecialChars rejects, as [start, end) pairs.
+func SpecialCharRanges(msg string) [][2]int {
+	var runes []rune
+	var offsets []int
+	for offset, ch := range msg {
+		runes = append(runes, ch)
+		offsets = append(offsets, offset)
+	}
+	offsets = append(offsets, len(msg))
+
+	var ranges [][2]int
+	for i := range runes {
+		if !isSpecialChar(runes, i) {
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1][1] == offsets[i] {
+			ranges[n-1][1] = offsets[i+1]
+		} else {
+			ranges = append(ranges, [2]int{offsets[i], offsets[i+1]})
+		}
+	}
+	return ranges
+}
+
+func isSpecialChar(runes []rune, i int) bool {
+	ch := runes[i]
+	if unicode.IsLetter(ch) || unicode.IsDigit(ch) || unicode.IsSpace(ch) {
+		return false
+	}
+	if isRepeatedChar(runes, i, ch) {
+		return true
+	}
+	if slices.Contains(allowedPunctuation, ch) {
+		return false
+	}
+	return isEmoji(ch) || isProblematicSpecialChar(ch)
+}
+
 func CheckNoSpecialChars(msg string) (bool, rune) {
 	runes := []rune(msg)
 	dotCount := 0
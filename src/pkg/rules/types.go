@@ -2,24 +2,124 @@ package rules
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/demidshumakher/loglinter/pkg/logcall"
 )
 
 type RuleResult struct {
 	Passed       bool
 	Message      string
 	SuggestedFix *SuggestedFix
+	// Expr is the expression the result refers to. When nil the result
+	// refers to the log message.
+	Expr ast.Expr
+	// Pos and End, when valid, are the range the result refers to, such as
+	// the bytes of a string literal obtained with Segment.Range. They take
+	// precedence over Expr.
+	Pos, End token.Pos
+	Related  []RelatedInformation
+}
+
+type RelatedInformation struct {
+	Node    ast.Node
+	Message string
 }
 
 type SuggestedFix struct {
 	Message string
 	NewText string
+	// Edits, when set, are applied instead of replacing the reported
+	// expression with NewText.
+	Edits []TextEdit
+}
+
+type TextEdit struct {
+	Pos     token.Pos
+	End     token.Pos
+	NewText string
 }
 
+// Backend identifies the logging library of a log call.
+type Backend = logcall.Backend
+
+const (
+	BackendSlog     = logcall.BackendSlog
+	BackendZap      = logcall.BackendZap
+	BackendZapSugar = logcall.BackendZapSugar
+	BackendLog      = logcall.BackendLog
+)
+
+// CheckContext describes a log call to the rules checking it. Rules built
+// outside this module receive the same context as the built-in ones.
 type CheckContext struct {
 	MsgExpr ast.Expr
-	Msg     string
+	// Msg is the text of the string literals of the message, as written in
+	// the source.
+	Msg string
+	// Constant reports whether the message is a constant expression, and
+	// ConstMsg is its value.
+	Constant bool
+	ConstMsg string
+	// Segments are the string literals the message is built from.
+	Segments []Segment
+	// Format reports whether the message is a printf-style format string.
+	Format bool
+
+	Call *ast.CallExpr
+	// Func is the called log function or method, nil if it could not be
+	// resolved.
+	Func    *types.Func
+	Backend Backend
+	Method  string
+	Level   Level
+	// PackageLevel reports whether the call goes through a package-level
+	// function such as slog.Info or log.Printf rather than a logger value.
+	PackageLevel bool
+	Pass         *analysis.Pass
+	TypesInfo    *types.Info
+	// File is the file of the call and Package the package being analyzed.
+	File    *ast.File
+	Package *types.Package
+	// Stack is the path of nodes from the file down to the call, inclusive.
+	Stack []ast.Node
+	// Args are the attribute arguments following the message.
+	Args []ast.Expr
+	// ChainArgs are the attribute arguments of the With calls the logger
+	// was derived from within the same expression.
+	ChainArgs []ast.Expr
+	// KeyValues reports whether attributes are passed as alternating keys
+	// and values rather than as slog.Attr or zap.Field values.
+	KeyValues bool
+	// Attrs are the attributes parsed from ChainArgs and Args, up to the
+	// first malformed argument.
+	Attrs []Attr
+
+	report func(result *RuleResult)
+}
+
+// Report reports a failed result in addition to the one returned by Check,
+// so that a rule can report several findings for one log call. Passed
+// results are ignored.
+func (c *CheckContext) Report(result *RuleResult) {
+	if c.report == nil || result == nil || result.Passed {
+		return
+	}
+	c.report(result)
+}
+
+// SetReporter sets the function Report passes failed results to. It is
+// called by the analyzer before every rule is checked.
+func (c *CheckContext) SetReporter(report func(result *RuleResult)) {
+	c.report = report
 }
 
+// Rule checks log calls. Check returns the result for a log call; a rule
+// finding several problems in one call returns one of them and passes the
+// others to CheckContext.Report.
 type Rule interface {
 	Name() string
 	Description() string
@@ -29,6 +129,41 @@ type Rule interface {
 	Check(ctx *CheckContext) *RuleResult
 }
 
+// FinishRule is implemented by rules that keep state across the log calls of
+// a package. Finish is called after all of its log calls were checked.
+//
+// The analyzer builds and configures its rules once and checks packages
+// concurrently, so Check of a rule without package state must not change
+// the rule. A FinishRule gets its own rule for every package: a copy from
+// ForPackage if it is a StatefulRule, a newly built rule otherwise.
+type FinishRule interface {
+	Rule
+	Finish(pass *analysis.Pass)
+}
+
+// StatefulRule is implemented by rules that keep state for a package and
+// can copy their configuration, so that files read by Configure are read
+// once per analyzer.
+type StatefulRule interface {
+	FinishRule
+	// ForPackage returns a copy of the configured rule with empty state.
+	ForPackage() Rule
+}
+
+// PackageRule is implemented by rules that share their state with other
+// packages through facts.
+type PackageRule interface {
+	FinishRule
+	FactTypes() []analysis.Fact
+}
+
+// StdLogRule is implemented by rules that check calls of the standard log
+// package. Other rules only check them when the check_std_log setting is on.
+type StdLogRule interface {
+	Rule
+	ChecksStdLog() bool
+}
+
 type RuleBuilder func() Rule
 
 type BaseRule struct {
@@ -64,6 +199,14 @@ func ResultFail(message string) *RuleResult {
 	return &RuleResult{Passed: false, Message: message}
 }
 
+func ResultFailAt(expr ast.Expr, message string) *RuleResult {
+	return &RuleResult{Passed: false, Message: message, Expr: expr}
+}
+
+func ResultFailRange(pos, end token.Pos, message string) *RuleResult {
+	return &RuleResult{Passed: false, Message: message, Pos: pos, End: end}
+}
+
 func ResultFailWithSuggestion(message, suggestionMessage, newText string) *RuleResult {
 	return &RuleResult{
 		Passed:  false,
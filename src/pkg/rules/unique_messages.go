@@ -0,0 +1,228 @@
+package rules
+
+import (
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const RuleUniqueMessagesName = "unique_messages"
+
+// MessagesFact records the constant log messages of a package together with
+// the positions they are logged at.
+type MessagesFact struct {
+	Messages map[string][]MessagePosition
+}
+
+func (*MessagesFact) AFact() {}
+
+// MessagePosition is a position a message is logged at. Facts outlive the
+// file set of the package, so the position is stored by file name.
+type MessagePosition struct {
+	Package  string
+	Filename string
+	Line     int
+	Column   int
+}
+
+// String returns the package and the short position: app/store/store.go:6.
+func (p MessagePosition) String() string {
+	return fmt.Sprintf("%s/%s:%d", p.Package, filepath.Base(p.Filename), p.Line)
+}
+
+// pos returns the position in fset, or token.NoPos when the file is not in
+// fset, as when the imported package was loaded from export data.
+func (p MessagePosition) pos(fset *token.FileSet) token.Pos {
+	pos := token.NoPos
+	fset.Iterate(func(f *token.File) bool {
+		if f.Name() != p.Filename {
+			return true
+		}
+		if p.Line <= f.LineCount() {
+			pos = f.LineStart(p.Line) + token.Pos(p.Column-1)
+		}
+		return false
+	})
+	return pos
+}
+
+func (f *MessagesFact) String() string {
+	return fmt.Sprintf("messages(%d)", len(f.Messages))
+}
+
+type UniqueMessagesRule struct {
+	BaseRule
+	minLength    int
+	ignore       []string
+	ignoreLevels []Level
+
+	// local maps messages to their positions in the order they are logged.
+	local    map[string][]token.Pos
+	order    []string
+	imported map[string][]MessagePosition
+}
+
+func NewUniqueMessagesRule() Rule {
+	r := &UniqueMessagesRule{
+		BaseRule: NewBaseRule(RuleUniqueMessagesName, "Checks that a constant log message is logged in only one place"),
+		local:    make(map[string][]token.Pos),
+	}
+	r.SetEnabled(false)
+	return r
+}
+
+func (r *UniqueMessagesRule) ForPackage() Rule {
+	c := *r
+	c.local = make(map[string][]token.Pos)
+	c.order = nil
+	c.imported = nil
+	return &c
+}
+
+func (r *UniqueMessagesRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code: "LL018",
+		Options: []RuleOption{
+			{Name: "min_length", Type: "int", Default: "0", Description: "shorter messages are not checked"},
+			{Name: "ignore", Type: "[]string", Description: "generic messages that may repeat"},
+			{Name: "ignore_levels", Type: "[]string", Description: "levels that are not checked"},
+		},
+		Examples: []string{`slog.Error("failed to connect") // logged in another place too`},
+	}
+}
+
+func (r *UniqueMessagesRule) Configure(config map[string]any) error {
+	if err := r.BaseRule.Configure(config); err != nil {
+		return err
+	}
+
+	if value, ok := config["min_length"]; ok {
+		minLength, ok := toInt(value)
+		if !ok {
+			return fmt.Errorf("unique_messages: min_length %v is not a whole number", value)
+		}
+		r.minLength = minLength
+	}
+	if ignore, ok := config["ignore"].([]any); ok {
+		r.ignore = toStrings(ignore)
+	}
+	if levels, ok := config["ignore_levels"].([]any); ok {
+		ignoreLevels, err := parseLevels(toStrings(levels))
+		if err != nil {
+			return fmt.Errorf("unique_messages: %w", err)
+		}
+		r.ignoreLevels = ignoreLevels
+	}
+
+	return nil
+}
+
+func (r *UniqueMessagesRule) FactTypes() []analysis.Fact {
+	return []analysis.Fact{new(MessagesFact)}
+}
+
+func (r *UniqueMessagesRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil || ctx.MsgExpr == nil || slices.Contains(r.ignoreLevels, ctx.Level) {
+		return ResultPass()
+	}
+
+	msg := ctx.ConstMsg
+	if !ctx.Constant || r.ignored(msg) {
+		return ResultPass()
+	}
+
+	if _, seen := r.local[msg]; !seen {
+		r.order = append(r.order, msg)
+	}
+	r.local[msg] = append(r.local[msg], ctx.MsgExpr.Pos())
+	return ResultPass()
+}
+
+func (r *UniqueMessagesRule) ignored(msg string) bool {
+	if len(msg) < r.minLength {
+		return true
+	}
+	return slices.ContainsFunc(r.ignore, func(generic string) bool {
+		return strings.EqualFold(generic, msg)
+	})
+}
+
+// Finish reports every message logged more than once in the package or
+// already logged by an imported package, and exports the messages of the
+// package for its importers.
+func (r *UniqueMessagesRule) Finish(pass *analysis.Pass) {
+	if !r.Enabled() || len(r.local) == 0 {
+		return
+	}
+	r.loadImported(pass)
+
+	fact := &MessagesFact{Messages: make(map[string][]MessagePosition, len(r.local))}
+	for _, msg := range r.order {
+		positions := r.local[msg]
+		for _, pos := range positions {
+			p := pass.Fset.Position(pos)
+			fact.Messages[msg] = append(fact.Messages[msg], MessagePosition{
+				Package:  pass.Pkg.Path(),
+				Filename: p.Filename,
+				Line:     p.Line,
+				Column:   p.Column,
+			})
+		}
+
+		if len(positions) == 1 && len(r.imported[msg]) == 0 {
+			continue
+		}
+		for i, pos := range positions {
+			r.report(pass, msg, pos, slices.Delete(slices.Clone(positions), i, i+1))
+		}
+	}
+
+	pass.ExportPackageFact(fact)
+}
+
+func (r *UniqueMessagesRule) report(pass *analysis.Pass, msg string, pos token.Pos, others []token.Pos) {
+	locations := make([]string, 0, len(others)+len(r.imported[msg]))
+	diag := analysis.Diagnostic{Pos: pos, Category: r.Name()}
+	for _, other := range others {
+		locations = append(locations, shortPosition(pass.Fset, other))
+		diag.Related = append(diag.Related, analysis.RelatedInformation{
+			Pos:     other,
+			Message: fmt.Sprintf("log message %q is also logged here", msg),
+		})
+	}
+	for _, location := range r.imported[msg] {
+		locations = append(locations, location.String())
+		if other := location.pos(pass.Fset); other.IsValid() {
+			diag.Related = append(diag.Related, analysis.RelatedInformation{
+				Pos:     other,
+				Message: fmt.Sprintf("log message %q is also logged here", msg),
+			})
+		}
+	}
+
+	diag.Message = fmt.Sprintf("log message %q is not unique, also logged at %s", msg, strings.Join(locations, ", "))
+	pass.Report(diag)
+}
+
+func (r *UniqueMessagesRule) loadImported(pass *analysis.Pass) {
+	r.imported = make(map[string][]MessagePosition)
+
+	facts := pass.AllPackageFacts()
+	sort.Slice(facts, func(i, j int) bool {
+		return facts[i].Package.Path() < facts[j].Package.Path()
+	})
+	for _, pf := range facts {
+		fact, ok := pf.Fact.(*MessagesFact)
+		if !ok {
+			continue
+		}
+		for msg, positions := range fact.Messages {
+			r.imported[msg] = append(r.imported[msg], positions...)
+		}
+	}
+}
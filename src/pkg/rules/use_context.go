@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"fmt"
+	"go/types"
+)
+
+const RuleUseContextName = "use_context"
+
+var contextMethods = map[string]string{
+	"Debug": "DebugContext",
+	"Info":  "InfoContext",
+	"Warn":  "WarnContext",
+	"Error": "ErrorContext",
+}
+
+type UseContextRule struct {
+	BaseRule
+}
+
+func NewUseContextRule() Rule {
+	r := &UseContextRule{
+		BaseRule: NewBaseRule(RuleUseContextName, "Checks that context-aware log methods are used when a context is in scope"),
+	}
+	r.SetEnabled(false)
+	return r
+}
+
+func (r *UseContextRule) Doc() RuleDoc {
+	return RuleDoc{
+		Code:     "LL013",
+		Examples: []string{`slog.Info("request handled") // ctx is in scope`},
+	}
+}
+
+func (r *UseContextRule) Check(ctx *CheckContext) *RuleResult {
+	if !r.Enabled() || ctx.Pass == nil || ctx.Backend != BackendSlog {
+		return ResultPass()
+	}
+
+	method, ok := contextMethods[ctx.Method]
+	if !ok || len(ctx.Call.Args) == 0 {
+		return ResultPass()
+	}
+
+	ctxVar := nearestContext(ctx)
+	if ctxVar == nil {
+		return ResultPass()
+	}
+
+	sel := selectorOf(ctx.Call)
+	result := ResultFailAt(sel.Sel, fmt.Sprintf("use %s to pass the context %s to the log handler", method, ctxVar.Name()))
+	result.SuggestedFix = &SuggestedFix{
+		Message: fmt.Sprintf("Use %s(%s, ...)", method, ctxVar.Name()),
+		Edits: []TextEdit{
+			{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: method},
+			{Pos: ctx.Call.Args[0].Pos(), End: ctx.Call.Args[0].Pos(), NewText: ctxVar.Name() + ", "},
+		},
+	}
+	return result
+}
+
+// nearestContext returns the innermost context.Context variable declared in
+// the enclosing functions before the call.
+func nearestContext(ctx *CheckContext) *types.Var {
+	pkgScope := ctx.Pass.Pkg.Scope()
+	pos := ctx.Call.Pos()
+
+	for scope := pkgScope.Innermost(pos); scope != nil && scope != pkgScope && scope.Parent() != pkgScope; scope = scope.Parent() {
+		var nearest *types.Var
+		for _, name := range scope.Names() {
+			v, ok := scope.Lookup(name).(*types.Var)
+			if !ok || name == "_" || v.Pos() >= pos || !isContextType(v.Type()) {
+				continue
+			}
+			if nearest == nil || v.Pos() > nearest.Pos() {
+				nearest = v
+			}
+		}
+		if nearest != nil {
+			return nearest
+		}
+	}
+	return nil
+}
+
+func isContextType(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "context" && named.Obj().Name() == "Context"
+}
@@ -0,0 +1,159 @@
+// Package stats summarizes the log calls and findings of an analysis run.
+package stats
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/demidshumakher/loglinter/pkg/catalog"
+	"github.com/demidshumakher/loglinter/pkg/report"
+)
+
+// TopMessages is the number of repeated messages listed in the summary.
+const TopMessages = 10
+
+// Stats is a summary of logging usage and violations.
+type Stats struct {
+	Calls      int            `json:"calls"`
+	Packages   map[string]int `json:"packages"`
+	Backends   map[string]int `json:"backends"`
+	Levels     map[string]int `json:"levels"`
+	Violations map[string]int `json:"violations"`
+	// Constant and Dynamic count the calls with constant and computed
+	// messages.
+	Constant int `json:"constant"`
+	Dynamic  int `json:"dynamic"`
+	// Repeated lists the constant messages logged more than once, the most
+	// repeated first.
+	Repeated []MessageCount `json:"repeated"`
+}
+
+// MessageCount is the number of calls logging a message.
+type MessageCount struct {
+	Message string `json:"message"`
+	Count   int    `json:"count"`
+}
+
+// Compute summarizes log calls and findings. Calls seen more than once, as
+// happens when a package is also analyzed with its tests, are counted once.
+func Compute(calls []catalog.Entry, findings []report.Finding) *Stats {
+	s := &Stats{
+		Packages:   make(map[string]int),
+		Backends:   make(map[string]int),
+		Levels:     make(map[string]int),
+		Violations: make(map[string]int),
+		Repeated:   []MessageCount{},
+	}
+
+	type callKey struct {
+		file      string
+		line, end int
+		msg, expr string
+	}
+	seen := make(map[callKey]bool)
+	messages := make(map[string]int)
+	for _, call := range calls {
+		k := callKey{call.File, call.Line, call.EndLine, call.Message, call.Expr}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+
+		s.Calls++
+		s.Packages[call.Package]++
+		s.Backends[call.Logger]++
+		s.Levels[call.Level]++
+		if call.Expr != "" {
+			s.Dynamic++
+		} else {
+			s.Constant++
+			messages[call.Message]++
+		}
+	}
+
+	for _, f := range findings {
+		s.Violations[f.RuleID]++
+	}
+
+	for msg, count := range messages {
+		if count > 1 {
+			s.Repeated = append(s.Repeated, MessageCount{Message: msg, Count: count})
+		}
+	}
+	slices.SortFunc(s.Repeated, func(a, b MessageCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Message, b.Message))
+	})
+	if len(s.Repeated) > TopMessages {
+		s.Repeated = s.Repeated[:TopMessages]
+	}
+	return s
+}
+
+// ConstantPercent returns the share of calls with constant messages.
+func (s *Stats) ConstantPercent() float64 {
+	return percent(s.Constant, s.Calls)
+}
+
+// DynamicPercent returns the share of calls with computed messages.
+func (s *Stats) DynamicPercent() float64 {
+	return percent(s.Dynamic, s.Calls)
+}
+
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
+
+// WriteJSON writes the summary as indented JSON.
+func (s *Stats) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// WriteText writes the summary as aligned tables.
+func (s *Stats) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "log calls\t%d\n", s.Calls)
+	fmt.Fprintf(tw, "constant messages\t%d\t%.1f%%\n", s.Constant, s.ConstantPercent())
+	fmt.Fprintf(tw, "dynamic messages\t%d\t%.1f%%\n", s.Dynamic, s.DynamicPercent())
+
+	writeCounts(tw, "package", "calls", s.Packages)
+	writeCounts(tw, "backend", "calls", s.Backends)
+	writeCounts(tw, "level", "calls", s.Levels)
+	writeCounts(tw, "rule", "violations", s.Violations)
+
+	if len(s.Repeated) > 0 {
+		fmt.Fprintf(tw, "\nrepeated message\tcalls\n")
+		for _, m := range s.Repeated {
+			fmt.Fprintf(tw, "%q\t%d\n", m.Message, m.Count)
+		}
+	}
+	return tw.Flush()
+}
+
+// writeCounts writes a table of counts, the largest first.
+func writeCounts(w io.Writer, title, column string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+
+	fmt.Fprintf(w, "\n%s\t%s\n", title, column)
+	for _, name := range names {
+		fmt.Fprintf(w, "%s\t%d\n", name, counts[name])
+	}
+}
@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/demidshumakher/loglinter/pkg/catalog"
+	"github.com/demidshumakher/loglinter/pkg/report"
+)
+
+func TestCompute(t *testing.T) {
+	calls := []catalog.Entry{
+		{Package: "example.com/app", File: "app.go", Line: 3, EndLine: 3, Logger: "slog", Level: "info", Message: "request handled"},
+		{Package: "example.com/app", File: "app.go", Line: 8, EndLine: 8, Logger: "slog", Level: "info", Message: "request handled"},
+		{Package: "example.com/app", File: "app.go", Line: 9, EndLine: 10, Logger: "zap", Level: "error", Expr: `"failed: " + name`},
+		{Package: "example.com/store", File: "store.go", Line: 5, EndLine: 5, Logger: "zap", Level: "warn", Message: "slow query"},
+		// The same call seen again when the package is analyzed with its tests.
+		{Package: "example.com/store", File: "store.go", Line: 5, EndLine: 5, Logger: "zap", Level: "warn", Message: "slow query"},
+	}
+	findings := []report.Finding{{RuleID: "lowercase"}, {RuleID: "lowercase"}, {RuleID: "error_attr"}}
+
+	got := Compute(calls, findings)
+	want := &Stats{
+		Calls:      4,
+		Packages:   map[string]int{"example.com/app": 3, "example.com/store": 1},
+		Backends:   map[string]int{"slog": 2, "zap": 2},
+		Levels:     map[string]int{"info": 2, "error": 1, "warn": 1},
+		Violations: map[string]int{"lowercase": 2, "error_attr": 1},
+		Constant:   3,
+		Dynamic:    1,
+		Repeated:   []MessageCount{{Message: "request handled", Count: 2}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compute =\n%+v\nwant\n%+v", got, want)
+	}
+	if p := got.ConstantPercent(); p != 75 {
+		t.Errorf("ConstantPercent = %v, want 75", p)
+	}
+
+	var buf bytes.Buffer
+	if err := got.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"request handled"  2`)) {
+		t.Errorf("WriteText output misses repeated messages:\n%s", buf.String())
+	}
+}
@@ -1,3 +1,15 @@
+// Package loglinter is the golangci-lint module plugin of the linter.
+//
+// A module with company rules registers them in its init function with
+// rules.RegisterRule and registers the plugin under its own name:
+//
+//	func init() {
+//		rules.RegisterRule("no_todo", NewNoTodoRule)
+//		register.Plugin("companylog", loglinter.New)
+//	}
+//
+// The built-in rules are registered when the rules package is initialized, so
+// they check a log call before the rules registered by other modules.
 package loglinter
 
 import (
@@ -9,9 +21,13 @@ import (
 )
 
 func init() {
-	register.Plugin("loglinter", func(conf any) (register.LinterPlugin, error) {
-		return &plugin{config: conf}, nil
-	})
+	register.Plugin("loglinter", New)
+}
+
+// New builds the plugin from its golangci-lint settings. The plugin runs
+// every registered rule, including the ones registered by other modules.
+func New(conf any) (register.LinterPlugin, error) {
+	return &plugin{config: conf}, nil
 }
 
 type plugin struct {
@@ -19,11 +35,9 @@ type plugin struct {
 }
 
 func (p *plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
-	return []*analysis.Analyzer{
-		analyzer.Analyzer(p.config),
-	}, nil
+	return analyzer.Analyzers(p.config)
 }
 
 func (p *plugin) GetLoadMode() string {
-	return register.LoadModeSyntax
+	return register.LoadModeTypesInfo
 }
@@ -0,0 +1,26 @@
+package attrkeys // want package:"keys\\(HTTPStatus:int, level:string, msg:string, request.id:int, request_id:string, user-id:int, userID:int, user_id:int\\)"
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const userKey = "userID"
+
+func testAttrKeys(logger *zap.Logger, sugar *zap.SugaredLogger, id int) {
+	// Valid keys
+	slog.Info("user logged in", "user_id", id, slog.String("request_id", "abc"))
+	logger.Info("user logged in", zap.Int("user_id", id))
+
+	// Invalid: key style
+	slog.Info("user logged in", "userID", id)               // want "log key \"userID\" should be snake_case"
+	slog.Info("user logged in", slog.Int("HTTPStatus", id)) // want "log key \"HTTPStatus\" should be snake_case"
+	logger.Info("user logged in", zap.Int("user-id", id))   // want "log key \"user-id\" should be snake_case"
+	sugar.Infow("user logged in", "request.id", id)         // want "log key \"request.id\" should be snake_case"
+	slog.With(userKey, id)                                  // want "log key \"userID\" should be snake_case"
+
+	// Invalid: reserved keys
+	slog.Info("user logged in", "msg", "hello")            // want "log key \"msg\" is reserved"
+	logger.Info("user logged in", zap.String("level", "")) // want "log key \"level\" is reserved"
+}
@@ -0,0 +1,23 @@
+package catalogexport // want package:"keys\\(attempt:int, user_id:int\\)"
+
+import (
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const msgStarted = "server started"
+
+type Server struct {
+	logger *zap.Logger
+}
+
+func (s *Server) Start(userID int) {
+	s.logger.With(zap.Int("user_id", userID)).Info(msgStarted, zap.Int("attempt", 1))
+}
+
+func Serve(name string) {
+	slog.Warn("serving "+name, "user_id", 42)
+	log.Printf("listening on %s", name)
+}
@@ -0,0 +1,22 @@
+package main // want `message catalog entry DB-002 "database migrated" is never logged` package:"catalog\\(3\\)" package:"keys\\(event_id:string\\)"
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+
+	"catalogrule/lib"
+)
+
+const msgLoggedIn = "user logged in"
+
+func main() {
+	logger, _ := zap.NewProduction()
+	lib.Reconnect(logger)
+
+	logger.Info(msgLoggedIn, zap.String("event_id", "AUTH-001"))
+	logger.With(zap.String("event_id", "AUTH-002")).Info("user logged out")
+	slog.Info("user logged in", "event_id", "AUTH-002") // want `event_id of log message "user logged in" should be "AUTH-001"`
+	slog.Info("user logged in")                         // want `log message "user logged in" should have the attribute event_id="AUTH-001"`
+	slog.Info("cache warmed up")                        // want `log message "cache warmed up" is not in the message catalog .*catalog.yaml`
+}
@@ -0,0 +1,7 @@
+package lib // want package:"catalog\\(1\\)" package:"keys\\(event_id:string\\)"
+
+import "go.uber.org/zap"
+
+func Reconnect(logger *zap.Logger) {
+	logger.Warn("database connection lost", zap.String("event_id", "DB-001"))
+}
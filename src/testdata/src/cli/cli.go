@@ -0,0 +1,9 @@
+package cli
+
+import "log/slog"
+
+func serve(port int) {
+	slog.Info("Starting server")
+	slog.Info("server started", "port", port)
+	slog.Warn("connection failed!!")
+}
@@ -0,0 +1,13 @@
+package customrule // want package:"keys\\(tenant:string, user_id:string, user_name:string\\)"
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func handle(logger *zap.Logger, id, name string) {
+	slog.Info("request handled", "user_id", id, "user_name", name) // want `attribute key "user_id" is personal data` `attribute key "user_name" is personal data`
+	logger.With(zap.String("user_id", id)).Info("request handled") // want `attribute key "user_id" is personal data`
+	slog.Info("request handled", "tenant", name)
+}
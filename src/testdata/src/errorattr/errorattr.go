@@ -0,0 +1,45 @@
+package errorattr // want package:"keys\\(attempt:int\\)"
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func connect() error { return errors.New("connection refused") }
+
+func testSlog(ctx context.Context, logger *slog.Logger) {
+	slog.Error("failed to start") // want "error-level log call should include an error attribute"
+
+	err := connect()
+
+	// Valid: the error is attached
+	slog.Error("failed to connect", "error", err)
+	logger.ErrorContext(ctx, "failed to connect", slog.Any("error", err))
+	logger.With("error", err).Error("failed to connect")
+	slog.Info("connection closed")
+
+	// Invalid: the err variable in scope is appended
+	slog.Error("failed to connect")                             // want "error-level log call should include an error attribute"
+	logger.ErrorContext(ctx, "failed to connect", "attempt", 3) // want "error-level log call should include an error attribute"
+	slog.LogAttrs(ctx, slog.LevelError, "failed to connect")
+}
+
+func testZap(logger *zap.Logger, sugar *zap.SugaredLogger) {
+	if err := connect(); err != nil {
+		// Valid: the error is attached
+		logger.Error("failed to connect", zap.Error(err))
+		logger.Fatal("failed to connect", zap.NamedError("cause", err))
+		sugar.Errorw("failed to connect", "error", err)
+
+		// Invalid: the err variable in scope is appended
+		logger.Error("failed to connect")                         // want "error-level log call should include an error attribute"
+		logger.DPanic("failed to connect", zap.Int("attempt", 3)) // want "error-level log call should include an error attribute"
+		sugar.Fatalw("failed to connect")                         // want "error-level log call should include an error attribute"
+	}
+
+	// Invalid: no err variable in scope, so no fix is offered
+	logger.Error("failed to start") // want "error-level log call should include an error attribute"
+}
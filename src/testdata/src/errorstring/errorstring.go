@@ -0,0 +1,29 @@
+package errorstring // want package:"keys\\(cause:string, error:string\\)"
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func testErrorString(logger *zap.Logger, sugar *zap.SugaredLogger) {
+	err := errors.New("connection refused")
+
+	// Valid: the error is passed as an attribute
+	slog.Error("failed to connect", "error", err)
+	logger.Error("failed to connect", zap.Error(err))
+
+	// Invalid: the error is formatted into the message
+	slog.Error("failed to connect: " + err.Error())                  // want "log message should not contain a formatted error, pass it as an attribute"
+	logger.Warn(fmt.Sprintf("failed to connect: %v", err))           // want "log message should not contain a formatted error, pass it as an attribute"
+	slog.Info(fmt.Sprintf("failed to connect %d times: %v", 3, err)) // want "log message should not contain a formatted error, pass it as an attribute"
+
+	// Invalid: the error is formatted into a string attribute
+	logger.Error("failed to connect", zap.String("error", err.Error())) // want "log attribute should hold the error itself rather than its string"
+	logger.Warn("failed to connect", zap.String("cause", err.Error()))  // want "log attribute should hold the error itself rather than its string"
+	slog.Warn("failed to connect", slog.String("cause", err.Error()))   // want "log attribute should hold the error itself rather than its string"
+	sugar.Errorw("failed to connect", "error", fmt.Sprint(err))         // want "log attribute should hold the error itself rather than its string"
+	sugar.Infow("failed to connect", zap.String("cause", err.Error()))  // want "log attribute should hold the error itself rather than its string"
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"log"
+
+	"go.uber.org/zap"
+)
+
+func main() {
+	logger, _ := zap.NewProduction()
+	err := errors.New("address in use")
+
+	// Valid: package main may exit
+	log.Fatal("failed to start")
+	logger.Fatal("failed to start", zap.Error(err))
+
+	// Invalid: Fatal skips cleanup, Panic crashes the program or masks a panic
+	go func() {
+		log.Fatalf("worker failed: %d", 1) // want "log.Fatalf inside a goroutine exits the program without running deferred cleanup"
+	}()
+	go func() {
+		logger.Panic("worker crashed") // want "logger.Panic inside a goroutine panics and crashes the whole program"
+	}()
+	defer func() {
+		logger.Panic("shutdown failed") // want "logger.Panic inside a deferred function panics during cleanup and masks any panic in progress"
+	}()
+	defer log.Println("stopped")
+
+	// Valid: the literal is not run by go or defer
+	run(func() {
+		log.Fatal("task failed")
+	})
+}
+
+func run(f func()) { f() }
@@ -0,0 +1,16 @@
+package lib
+
+import (
+	"log"
+
+	"go.uber.org/zap"
+)
+
+func Load(logger *zap.Logger, sugar *zap.SugaredLogger, err error) {
+	logger.Fatal("failed to load config", zap.Error(err)) // want "logger.Fatal exits the program, only use it in package main"
+	sugar.Panicw("failed to load config")                 // want "sugar.Panicw panics, only use it in package main"
+	log.Panicf("failed to load %s", "config")             // want "log.Panicf panics, only use it in package main"
+
+	// Valid: DPanic only panics in development
+	logger.DPanic("failed to load config", zap.Error(err))
+}
@@ -0,0 +1,12 @@
+package app
+
+import (
+	"log"
+	"log/slog"
+)
+
+func Run() {
+	// Valid: the package is not restricted
+	slog.Info("application started")
+	log.Printf("listening on %d", 8080)
+}
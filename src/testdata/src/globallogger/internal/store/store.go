@@ -0,0 +1,7 @@
+package store
+
+import "log/slog"
+
+func Open() {
+	slog.Info("store opened") // want "global logger slog.Info is not allowed here, inject a store.Logger"
+}
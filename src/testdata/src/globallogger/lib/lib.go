@@ -0,0 +1,25 @@
+package lib
+
+import (
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	logger *slog.Logger
+}
+
+func (s *Service) Start() {
+	// Valid: injected logger
+	s.logger.Info("service started")
+
+	// Invalid: global loggers
+	slog.Info("service started")              // want "global logger slog.Info is not allowed here, inject a \\*slog.Logger"
+	log.Printf("service started on %d", 8080) // want "global logger log.Printf is not allowed here, inject a \\*log.Logger"
+	logger := slog.Default()                  // want "global logger slog.Default\\(\\) is not allowed here, inject a \\*slog.Logger"
+	logger.Info("service started")
+	zap.L().Info("service started")  // want "global logger zap.L\\(\\) is not allowed here, inject a \\*zap.Logger"
+	zap.S().Infow("service started") // want "global logger zap.S\\(\\) is not allowed here, inject a \\*zap.SugaredLogger"
+}
@@ -0,0 +1,48 @@
+package zap
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+type Field = zapcore.Field
+
+type Logger struct{}
+
+func NewProduction() (*Logger, error) { return &Logger{}, nil }
+func L() *Logger                      { return &Logger{} }
+func S() *SugaredLogger               { return &SugaredLogger{} }
+
+func (l *Logger) Sugar() *SugaredLogger              { return &SugaredLogger{} }
+func (l *Logger) With(fields ...Field) *Logger       { return l }
+func (l *Logger) Debug(msg string, fields ...Field)  {}
+func (l *Logger) Info(msg string, fields ...Field)   {}
+func (l *Logger) Warn(msg string, fields ...Field)   {}
+func (l *Logger) Error(msg string, fields ...Field)  {}
+func (l *Logger) DPanic(msg string, fields ...Field) {}
+func (l *Logger) Panic(msg string, fields ...Field)  {}
+func (l *Logger) Fatal(msg string, fields ...Field)  {}
+
+type SugaredLogger struct{}
+
+func (s *SugaredLogger) Desugar() *Logger                         { return &Logger{} }
+func (s *SugaredLogger) With(args ...any) *SugaredLogger          { return s }
+func (s *SugaredLogger) Debugw(msg string, keysAndValues ...any)  {}
+func (s *SugaredLogger) Infow(msg string, keysAndValues ...any)   {}
+func (s *SugaredLogger) Warnw(msg string, keysAndValues ...any)   {}
+func (s *SugaredLogger) Errorw(msg string, keysAndValues ...any)  {}
+func (s *SugaredLogger) DPanicw(msg string, keysAndValues ...any) {}
+func (s *SugaredLogger) Panicw(msg string, keysAndValues ...any)  {}
+func (s *SugaredLogger) Fatalw(msg string, keysAndValues ...any)  {}
+
+func Any(key string, value any) Field              { return Field{Key: key, Interface: value} }
+func String(key string, val string) Field          { return Field{Key: key, Interface: val} }
+func Int(key string, val int) Field                { return Field{Key: key, Interface: val} }
+func Int64(key string, val int64) Field            { return Field{Key: key, Interface: val} }
+func Float64(key string, val float64) Field        { return Field{Key: key, Interface: val} }
+func Bool(key string, val bool) Field              { return Field{Key: key, Interface: val} }
+func Duration(key string, val time.Duration) Field { return Field{Key: key, Interface: val} }
+func Time(key string, val time.Time) Field         { return Field{Key: key, Interface: val} }
+func Error(err error) Field                        { return NamedError("error", err) }
+func NamedError(key string, err error) Field       { return Field{Key: key, Interface: err} }
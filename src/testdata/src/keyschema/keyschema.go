@@ -0,0 +1,20 @@
+package keyschema // want package:"keys\\(duration:time.Duration, payload:int, user_id:string\\)"
+
+import (
+	"log/slog"
+	"time"
+)
+
+func testKeySchema(id int, name string, elapsed time.Duration, err error, v any) {
+	// Valid: keys and types from the schema
+	slog.Info("user logged in", "user_id", name, "duration", elapsed)
+	slog.Info("payload received", "payload", 42)
+	slog.Info("payload received", slog.Any("payload", v))
+
+	// Invalid
+	slog.Info("user logged in", "user_id", id)        // want "log key \"user_id\" should have a value of type string, got int"
+	slog.Info("request started", "request_id", name)  // want "log key \"request_id\" is not in the key schema"
+	slog.Info("request failed", "err", err)           // want "log key \"err\" is not in the key schema"
+	slog.Info("request failed", slog.Any("cause", v)) // want "log key \"cause\" is not in the key schema"
+	slog.Info("request failed", "reason", nil)        // want "log key \"reason\" is not in the key schema"
+}
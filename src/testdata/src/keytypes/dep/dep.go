@@ -0,0 +1,7 @@
+package dep // want package:"keys\\(attempt:int, user_id:string\\)"
+
+import "log/slog"
+
+func LogUser(id string) {
+	slog.Info("user loaded", "user_id", id, "attempt", 1)
+}
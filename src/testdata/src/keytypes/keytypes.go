@@ -0,0 +1,24 @@
+package keytypes // want package:"keys\\(attempt:int, request_id:string, user_id:int\\)"
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+
+	"keytypes/dep"
+)
+
+func testKeyTypes(logger *zap.Logger, id int, requestID string) {
+	dep.LogUser("42")
+
+	// Valid: same types as in the dependency
+	slog.Info("retrying request", "attempt", 2)
+	logger.Info("retrying request", zap.Int("attempt", 3))
+
+	// Invalid: the dependency logs user_id as a string
+	slog.Info("user logged in", "user_id", id) // want "log key \"user_id\" is logged as int here and as string in package keytypes/dep at dep.go:6"
+
+	// Invalid: request_id is logged with different types in this package
+	slog.Info("request started", "request_id", requestID)
+	logger.Info("request finished", zap.Int("request_id", id)) // want "log key \"request_id\" is logged as int here and as string at keytypes.go:22"
+}
@@ -0,0 +1,47 @@
+package kvpairs // want package:"keys\\(key:string, role:string, status:int, user:int\\)"
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func testSlogPairs(ctx context.Context, logger *slog.Logger, id int) {
+	// Valid key-value arguments
+	slog.Info("user logged in", "user", id, "role", "admin")
+	slog.Info("user logged in", slog.Int("user", id), "role", "admin")
+	logger.Warn("cache miss", "key", "user_123")
+	logger.InfoContext(ctx, "request handled", "status", 200)
+	slog.Log(ctx, slog.LevelInfo, "request handled", "status", 200)
+
+	// Invalid: key without value
+	slog.Info("user logged in", "user", id, "orphan")    // want "log key has no matching value"
+	logger.ErrorContext(ctx, "request failed", "status") // want "log key has no matching value"
+
+	// Invalid: non-string key
+	slog.Info("user logged in", id, "user") // want "log key should be a string or an attribute, got int"
+
+	// Invalid: duplicate keys
+	slog.Info("user logged in", "user", id, "user", id)                   // want "duplicate log key \"user\""
+	logger.Info("user logged in", slog.Int("user", id), "user", id)       // want "duplicate log key \"user\""
+	slog.With("user", id).Info("user logged in", "user", id)              // want "log key \"user\" is already set by With"
+	logger.With("user", id).With("role", "admin").Info("ok", "role", "x") // want "log key \"role\" is already set by With"
+
+	// Invalid: With arguments are checked as well
+	slog.With("user") // want "log key has no matching value"
+}
+
+func testZapSugarPairs(sugar *zap.SugaredLogger, logger *zap.Logger, id int) {
+	// Valid key-value arguments
+	sugar.Infow("user logged in", "user", id)
+	sugar.Infow("user logged in", zap.Int("user", id), "role", "admin")
+
+	// Structured zap fields are not key-value pairs
+	logger.Info("user logged in", zap.Int("user", id), zap.Int("user", id))
+
+	// Invalid
+	sugar.Errorw("request failed", "status")                   // want "log key has no matching value"
+	sugar.With("user", id).Infow("user logged in", "user", id) // want "log key \"user\" is already set by With"
+	sugar.Warnw("cache miss", 42, "key")                       // want "log key should be a string or an attribute, got int"
+}
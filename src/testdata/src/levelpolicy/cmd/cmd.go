@@ -0,0 +1,8 @@
+package cmd
+
+import "log/slog"
+
+func Run() {
+	slog.Info("command started")
+	slog.Debug("parsing flags") // want "log level debug is not allowed in package levelpolicy/cmd"
+}
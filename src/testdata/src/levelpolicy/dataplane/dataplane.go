@@ -0,0 +1,21 @@
+package dataplane
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const levelTrace = slog.Level(-8)
+
+func Forward(ctx context.Context, logger *zap.Logger, sugar *zap.SugaredLogger, err error) {
+	logger.Info("packet forwarded")
+	logger.Debug("packet forwarded")
+	slog.Log(ctx, levelTrace, "packet forwarded")
+
+	logger.Warn("queue is full")                                                            // want "log level warn is not allowed in package levelpolicy/dataplane"
+	sugar.Errorw("failed to forward packet", "error", err)                                  // want "log level error is not allowed in package levelpolicy/dataplane"
+	slog.Log(ctx, slog.LevelWarn+1, "queue is almost full")                                 // want "log level warn is not allowed in package levelpolicy/dataplane"
+	slog.LogAttrs(ctx, slog.LevelError, "failed to forward packet", slog.Any("error", err)) // want "log level error is not allowed in package levelpolicy/dataplane"
+}
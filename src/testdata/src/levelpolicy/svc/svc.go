@@ -0,0 +1,7 @@
+package svc
+
+import "log/slog"
+
+func Serve(err error) {
+	slog.Error("failed to serve", "error", err)
+}
@@ -0,0 +1,13 @@
+package svc
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+)
+
+func TestServe(t *testing.T) {
+	err := errors.New("address in use")
+	slog.Info("test started")
+	slog.Error("test failed", "error", err) // want "log level error is not allowed in file svc_test.go"
+}
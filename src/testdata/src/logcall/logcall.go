@@ -0,0 +1,20 @@
+package logcall
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const started = "server started"
+
+func serve(ctx context.Context, logger *zap.Logger, sl *slog.Logger, port int) {
+	slog.Info(started, "port", port)
+	sl.Log(ctx, slog.LevelWarn, "slow start")
+	logger.With(zap.Int("port", port)).Error("listen failed", zap.String("addr", "localhost"))
+	log.Printf("listening on %d", port)
+	fmt := "port " + "%d"
+	log.Printf(fmt, port)
+}
@@ -0,0 +1,62 @@
+package logreturn
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func connect() error { return errors.New("connection refused") }
+
+func logAndReturn(logger *zap.Logger) error {
+	if err := connect(); err != nil {
+		logger.Error("failed to connect", zap.Error(err)) // want "error err is logged and then returned, handle it once"
+		return err
+	}
+	return nil
+}
+
+func logAndWrap() error {
+	err := connect()
+	if err != nil {
+		slog.Error("failed to connect", "error", err) // want "error err is logged and then returned, handle it once"
+		return fmt.Errorf("connect: %w", err)
+	}
+	return nil
+}
+
+func logAndReturnInClosure() {
+	_ = func() error {
+		err := connect()
+		slog.Error("failed to connect", "error", err) // want "error err is logged and then returned, handle it once"
+		return err
+	}
+}
+
+func logOnly(logger *zap.Logger) {
+	if err := connect(); err != nil {
+		logger.Error("failed to connect", zap.Error(err))
+		return
+	}
+}
+
+func logAndReturnOther() error {
+	if err := connect(); err != nil {
+		slog.Error("failed to connect", "error", err)
+		return errors.New("service unavailable")
+	}
+	return nil
+}
+
+func logInBranch(retry bool) error {
+	err := connect()
+	if err != nil {
+		slog.Error("failed to connect", "error", err)
+		if retry {
+			err = connect()
+		}
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package looplogging // want package:"keys\\(index:int, item:string\\)"
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type sampler struct{}
+
+func (sampler) Allow() bool { return true }
+
+func process(items []string, logger *zap.Logger, sugar *zap.SugaredLogger) error {
+	for _, item := range items {
+		logger.Info("processing item", zap.String("item", item))  // want "info log call inside a loop, sample it or move it out of the loop"
+		logger.Debug("processing item", zap.String("item", item)) // want "debug log call inside a loop, sample it or move it out of the loop"
+	}
+
+	for i := 0; i < len(items); i++ {
+		sugar.Warnw("item is skipped", "index", i)
+	}
+
+	for i, item := range items {
+		if i%100 == 0 {
+			logger.Info("processing item", zap.String("item", item))
+		}
+		if item == "" {
+			logger.Warn("empty item", zap.Int("index", i))
+			return nil
+		}
+		if item == "stop" {
+			logger.Info("stop item found")
+			break
+		}
+		switch item {
+		case "skip":
+			logger.Info("skip item found") // want "info log call inside a loop, sample it or move it out of the loop"
+			break
+		}
+	}
+
+	return nil
+}
+
+func sampled(items []string, s sampler) {
+	for _, item := range items {
+		if !s.Allow() {
+			continue
+		}
+		slog.Info("processing item", "item", item)
+	}
+
+	for _, item := range items {
+		go func() {
+			slog.Info("processing item", "item", item)
+		}()
+	}
+}
+
+func enabled(ctx context.Context, items []string) {
+	for _, item := range items {
+		if slog.Default().Enabled(ctx, slog.LevelInfo) {
+			slog.InfoContext(ctx, "processing item", "item", item)
+		}
+	}
+
+	for range items {
+		slog.Log(ctx, slog.LevelError, "processing failed") // want "error log call inside a loop, sample it or move it out of the loop"
+	}
+}
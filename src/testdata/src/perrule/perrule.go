@@ -0,0 +1,9 @@
+package perrule
+
+import "log/slog"
+
+func serve(password string) {
+	slog.Info("Starting server!!", "user_id", 1) // want "log message should start with a lowercase letter"
+	slog.Info("login with " + password)
+	slog.Info("server stopped")
+}
@@ -0,0 +1,21 @@
+package semconv
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func testSemconv(logger *zap.Logger, method string, status int, userID string) {
+	// Valid semantic convention attributes
+	slog.Info("request handled", "http.request.method", method, "http.response.status_code", status)
+	logger.Info("user logged in", zap.String("user.id", userID))
+
+	// Invalid: close to a semantic convention attribute
+	slog.Info("request handled", "http_method", method)          // want "log key \"http_method\" should be the semantic convention attribute \"http.request.method\""
+	slog.Info("request handled", "httpRequestMethod", method)    // want "log key \"httpRequestMethod\" should be the semantic convention attribute \"http.request.method\""
+	logger.Info("user logged in", zap.String("user_id", userID)) // want "log key \"user_id\" should be the semantic convention attribute \"user.id\""
+
+	// Invalid: value type does not match the convention
+	slog.Info("request handled", "http.response.status_code", "200") // want "semantic convention attribute \"http.response.status_code\" should be int, got string"
+}
@@ -0,0 +1,22 @@
+package sensitivewords
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+const token = "X-Request-Token"
+
+type credentials struct {
+	user     string
+	password string
+}
+
+func login(creds credentials, secret []byte) {
+	slog.Info("header " + token + " is missing")
+	slog.Info("login of " + creds.user)
+	slog.Info("login with " + creds.password)                            // want "log message contains sensitive variable: password"
+	slog.Info(fmt.Sprintf("login with %s", creds.password))              // want "log message contains sensitive variable: password"
+	slog.Info("login with " + creds.password + " and " + string(secret)) // want "sensitive variable: password" "sensitive variable: secret"
+	slog.Info("secret prefix " + string(secret[:4]))                     // want "log message contains sensitive variable: secret"
+}
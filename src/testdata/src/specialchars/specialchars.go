@@ -0,0 +1,14 @@
+package specialchars
+
+import (
+	"log"
+	"log/slog"
+)
+
+func run(name string, n int) {
+	slog.Info("server started!!")                 // want "log message should not contain special characters or emojis"
+	slog.Info("done \u2705 and \U0001F680 again") // want "log message should not contain special characters or emojis" "log message should not contain special characters or emojis"
+	slog.Info("user " + name + " left!")          // want "log message should not contain special characters or emojis"
+	log.Printf("retry %d failed!!", n)            // want "log message should not contain special characters or emojis"
+	slog.Info(`path\to ~ file`)                   // want "log message should not contain special characters or emojis"
+}
@@ -0,0 +1,11 @@
+package stdlog
+
+import "log"
+
+func serve(password string) {
+	// Message rules skip the standard log package by default
+	log.Printf("Starting server!! with %s", password)
+	log.Print("запуск " + password)
+
+	log.Fatalf("failed to start: %v", password) // want "log.Fatalf exits the program, only use it in package main"
+}
@@ -0,0 +1,29 @@
+package app // want package:"messages\\(3\\)"
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+
+	"uniquemessages/store"
+)
+
+const msgRequestFailed = "request failed"
+
+func Run(logger *zap.Logger) {
+	store.Open()
+
+	slog.Info("failed to connect to database") // want `log message "failed to connect to database" is not unique, also logged at uniquemessages/store/store.go:6`
+
+	logger.Warn(msgRequestFailed) // want `log message "request failed" is not unique, also logged at app.go:19`
+	logger.Warn("request failed") // want `log message "request failed" is not unique, also logged at app.go:18`
+
+	slog.Info("done")
+	slog.Info("done")
+	slog.Info("ok")
+	slog.Info("ok")
+	slog.Debug("cache miss")
+	slog.Debug("cache miss")
+
+	slog.Info("application started")
+}
@@ -0,0 +1,8 @@
+package store // want package:"messages\\(2\\)"
+
+import "log/slog"
+
+func Open() {
+	slog.Info("failed to connect to database")
+	slog.Info("store opened")
+}
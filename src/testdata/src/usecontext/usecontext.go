@@ -0,0 +1,39 @@
+package usecontext // want package:"keys\\(elapsed_ms:int\\)"
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func handle(ctx context.Context, logger *slog.Logger) {
+	// Valid: the context is passed
+	slog.InfoContext(ctx, "request started")
+	logger.Log(ctx, slog.LevelInfo, "request started")
+
+	// Invalid: a context is in scope
+	slog.Info("request started")                   // want "use InfoContext to pass the context ctx to the log handler"
+	logger.Warn("slow request", "elapsed_ms", 250) // want "use WarnContext to pass the context ctx to the log handler"
+
+	go func() {
+		slog.Warn("request canceled") // want "use WarnContext to pass the context ctx to the log handler"
+	}()
+}
+
+func nearest(parent context.Context) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	_ = ctx
+
+	slog.Debug("request started") // want "use DebugContext to pass the context ctx to the log handler"
+}
+
+func withZap(ctx context.Context, zl *zap.Logger) {
+	// Valid: zap has no context-aware methods
+	zl.Info("request started")
+}
+
+func noContext() {
+	slog.Info("server started")
+}